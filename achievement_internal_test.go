@@ -0,0 +1,37 @@
+package steamweb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewAchievementCompletion(t *testing.T) {
+	achievements := &PlayerAchievements{
+		GameName: "Test",
+		Achievements: []PlayerAchievement{
+			{APIName: "rare", Achieved: 0},
+			{APIName: "done", Achieved: 1},
+			{APIName: "common", Achieved: 0},
+			{APIName: "unknown", Achieved: 0},
+		},
+	}
+	percentages := []AchievementPercentage{
+		{Name: "rare", Percent: 1.5},
+		{Name: "done", Percent: 90},
+		{Name: "common", Percent: 55.2},
+	}
+
+	completion := newAchievementCompletion(440, achievements, percentages)
+	require.Equal(t, 4, completion.Total)
+	require.Equal(t, 1, completion.Unlocked)
+	require.InDelta(t, 25.0, completion.Percent, 0.001)
+	require.Len(t, completion.Locked, 3)
+	require.Equal(t, "common", completion.Locked[0].APIName)
+	require.Equal(t, "rare", completion.Locked[1].APIName)
+	require.Equal(t, "unknown", completion.Locked[2].APIName)
+
+	empty := newAchievementCompletion(440, &PlayerAchievements{}, nil)
+	require.Zero(t, empty.Percent)
+	require.Empty(t, empty.Locked)
+}
@@ -0,0 +1,140 @@
+package steamweb
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/leighmacdonald/steamid/v4/steamid"
+	"github.com/pkg/errors"
+)
+
+// appIndex provides fast lookups over the app list. It is built once each time the app list is fetched and
+// cached alongside it.
+type appIndex struct {
+	byID map[steamid.AppID]App
+	// names holds every app sorted by its lower cased name for prefix searches.
+	names []indexedApp
+}
+
+type indexedApp struct {
+	name string
+	app  App
+}
+
+func newAppIndex(apps []App) *appIndex {
+	index := &appIndex{
+		byID:  make(map[steamid.AppID]App, len(apps)),
+		names: make([]indexedApp, 0, len(apps)),
+	}
+
+	for _, app := range apps {
+		index.byID[steamid.AppID(app.AppID)] = app
+
+		if app.Name != "" {
+			index.names = append(index.names, indexedApp{name: strings.ToLower(app.Name), app: app})
+		}
+	}
+
+	sort.Slice(index.names, func(i, j int) bool {
+		if index.names[i].name == index.names[j].name {
+			return index.names[i].app.AppID < index.names[j].app.AppID
+		}
+
+		return index.names[i].name < index.names[j].name
+	})
+
+	return index
+}
+
+// find returns the app with the matching id.
+func (idx *appIndex) find(appID steamid.AppID) (App, bool) {
+	app, found := idx.byID[appID]
+
+	return app, found
+}
+
+// prefix returns every app whose name starts with prefix, ignoring case, ordered by name.
+func (idx *appIndex) prefix(prefix string) []App {
+	prefix = strings.ToLower(prefix)
+	start := sort.Search(len(idx.names), func(i int) bool {
+		return idx.names[i].name >= prefix
+	})
+
+	var apps []App
+
+	for _, indexed := range idx.names[start:] {
+		if !strings.HasPrefix(indexed.name, prefix) {
+			break
+		}
+
+		apps = append(apps, indexed.app)
+	}
+
+	return apps
+}
+
+// getAppIndex returns the cached app index, fetching the app list if required.
+func getAppIndex(ctx context.Context, client HTTPClientHandler) (*appIndex, error) {
+	if index, found := getCached[*appIndex](ctx, cacheFrom(ctx), cacheKeyAppIndex); found {
+		return index, nil
+	}
+
+	apps, errApps := GetAppList(ctx, client)
+	if errApps != nil {
+		return nil, errApps
+	}
+
+	// The app list may have been served from the cache without its index. A freshly fetched list stores its
+	// index, so this lookup is never bypassed.
+	if index, found := getCached[*appIndex](context.Background(), cacheFrom(ctx), cacheKeyAppIndex); found {
+		return index, nil
+	}
+
+	index := newAppIndex(apps)
+	cacheFrom(ctx).set(cacheKeyAppIndex, index, cacheTTL(ctx, defaultCacheTTL))
+
+	return index, nil
+}
+
+// AppByID looks up a single app from the cached GetAppList results.
+func AppByID(ctx context.Context, client HTTPClientHandler, appID steamid.AppID) (App, bool, error) {
+	index, errIndex := getAppIndex(ctx, client)
+	if errIndex != nil {
+		return App{}, false, errIndex
+	}
+
+	app, found := index.find(appID)
+
+	return app, found, nil
+}
+
+// FindApps returns every app from the cached GetAppList results whose name starts with prefix, ignoring case.
+// Results are ordered by name.
+func FindApps(ctx context.Context, client HTTPClientHandler, prefix string) ([]App, error) {
+	index, errIndex := getAppIndex(ctx, client)
+	if errIndex != nil {
+		return nil, errIndex
+	}
+
+	return index.prefix(prefix), nil
+}
+
+// validateAppID returns ErrUnknownAppID when the context was created with WithValidateAppID and the app id is not
+// in the app list.
+func validateAppID(ctx context.Context, client HTTPClientHandler, appID steamid.AppID) error {
+	if !validateAppIDEnabled(ctx) {
+		return nil
+	}
+
+	_, found, errFind := AppByID(ctx, client, appID)
+	if errFind != nil {
+		return errFind
+	}
+
+	if !found {
+		return errors.Wrapf(ErrUnknownAppID, "%d", appID)
+	}
+
+	return nil
+}
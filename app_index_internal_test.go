@@ -0,0 +1,31 @@
+package steamweb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAppIndex(t *testing.T) {
+	index := newAppIndex([]App{
+		{AppID: 440, Name: "Team Fortress 2"},
+		{AppID: 730, Name: "Counter-Strike 2"},
+		{AppID: 20, Name: "Team Fortress Classic"},
+		{AppID: 1, Name: ""},
+	})
+
+	app, found := index.find(440)
+	require.True(t, found)
+	require.Equal(t, "Team Fortress 2", app.Name)
+
+	_, foundMissing := index.find(9999)
+	require.False(t, foundMissing)
+
+	apps := index.prefix("team FORTRESS")
+	require.Len(t, apps, 2)
+	require.Equal(t, 440, apps[0].AppID)
+	require.Equal(t, 20, apps[1].AppID)
+
+	require.Empty(t, index.prefix("dota"))
+	require.Len(t, index.prefix(""), 3)
+}
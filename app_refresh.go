@@ -0,0 +1,92 @@
+package steamweb
+
+import (
+	"context"
+	"time"
+)
+
+const (
+	defaultAppListRefreshInterval = time.Hour
+	// appListRefreshBaseBackoff is the delay before retrying a failed refresh, doubled for each consecutive
+	// failure up to the refresh interval.
+	appListRefreshBaseBackoff = 10 * time.Second
+)
+
+// StartAppListRefresh refreshes the cached GetAppList results every interval, starting immediately, so that
+// lookups such as AppByID and FindApps are always served from a warm cache. Refreshed results are cached for
+// twice the interval, or the default cache duration if longer, so a failed refresh does not expire the cache.
+// Failed refreshes are retried with backoff and logged when debug output is enabled with SetDebug. Overlapping
+// refreshes are skipped. The returned channel is closed once the context is cancelled or Shutdown is called, an
+// interval <= 0 uses a default of 1 hour.
+func StartAppListRefresh(ctx context.Context, client HTTPClientHandler, interval time.Duration) <-chan struct{} {
+	if interval <= 0 {
+		interval = defaultAppListRefreshInterval
+	}
+
+	done := make(chan struct{})
+
+	if errAcquire := lifecycle.acquire(); errAcquire != nil {
+		close(done)
+
+		return done
+	}
+
+	ctx, cancel := lifecycle.background(ctx)
+	backoff := RetryPolicy{BaseDelay: min(appListRefreshBaseBackoff, interval), MaxDelay: interval}
+
+	go func() {
+		defer func() {
+			cancel()
+			close(done)
+			lifecycle.release()
+		}()
+
+		timer := time.NewTimer(0)
+		defer timer.Stop()
+
+		failures := 0
+
+		for {
+			select {
+			case <-timer.C:
+			case <-ctx.Done():
+				return
+			}
+
+			if errRefresh := refreshAppList(ctx, client, interval); errRefresh != nil {
+				if ctx.Err() != nil {
+					return
+				}
+
+				failures++
+
+				debugf("Failed to refresh app list (attempt %d): %v\n", failures, errRefresh)
+				timer.Reset(backoff.delay(failures))
+
+				continue
+			}
+
+			failures = 0
+
+			timer.Reset(interval)
+		}
+	}()
+
+	return done
+}
+
+// refreshAppList fetches the app list, bypassing the cache. Nothing is done if another refresh is already running.
+func refreshAppList(ctx context.Context, client HTTPClientHandler, interval time.Duration) error {
+	refreshCache := cacheFrom(ctx)
+	if !refreshCache.appListRefresh.TryLock() {
+		return nil
+	}
+
+	defer refreshCache.appListRefresh.Unlock()
+
+	ttl := max(defaultCacheTTL, interval*2)
+
+	_, errApps := GetAppList(WithCacheTTL(WithCacheBypass(ctx), ttl), client)
+
+	return errApps
+}
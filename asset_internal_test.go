@@ -0,0 +1,48 @@
+package steamweb
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeAsset(t *testing.T) {
+	const body = `{
+		"classid": "101785959",
+		"name": "Mann Co. Supply Crate Key",
+		"market_hash_name": "Mann Co. Supply Crate Key",
+		"fraudwarnings": "",
+		"descriptions": {
+			"1": {"type": "html", "value": "second"},
+			"0": {"type": "html", "value": "first", "color": "7ea9d1"}
+		},
+		"actions": {"0": {"name": "Item Wiki Page...", "link": "https://wiki.teamfortress.com/"}},
+		"app_data": {"def_index": "5021", "quality": "6"}
+	}`
+
+	var raw any
+
+	require.NoError(t, json.Unmarshal([]byte(body), &raw))
+
+	asset, err := decodeAsset(raw)
+	require.NoError(t, err)
+	require.Equal(t, "101785959", asset.ClassID)
+	require.Equal(t, "Mann Co. Supply Crate Key", asset.MarketHashName)
+	require.Empty(t, asset.FraudWarnings)
+	require.Len(t, asset.Descriptions, 2)
+	require.Equal(t, "first", asset.Descriptions[0].Value)
+	require.Equal(t, "7ea9d1", asset.Descriptions[0].Color)
+	require.Equal(t, "second", asset.Descriptions[1].Value)
+	require.Len(t, asset.Actions, 1)
+	require.Equal(t, "5021", asset.AppData.DefIndex)
+
+	var rawEmpty any
+
+	require.NoError(t, json.Unmarshal([]byte(`{"classid": "1", "descriptions": "", "actions": "", "app_data": ""}`), &rawEmpty))
+
+	emptyAsset, errEmpty := decodeAsset(rawEmpty)
+	require.NoError(t, errEmpty)
+	require.Empty(t, emptyAsset.Descriptions)
+	require.Empty(t, emptyAsset.AppData.DefIndex)
+}
@@ -0,0 +1,38 @@
+package steamweb
+
+// ItemPricer prices inventory items for ValueBackpack. The package does not ship a price source, implementations
+// are expected to wrap an external one such as backpack.tf. The schema may be used to resolve the item definition.
+type ItemPricer interface {
+	// Price returns the value of the item in cents, ok is false when the item has no known price.
+	Price(item InventoryItem, schema *Schema) (cents int, ok bool)
+}
+
+// ItemPricerFunc adapts a function to the ItemPricer interface.
+type ItemPricerFunc func(item InventoryItem, schema *Schema) (int, bool)
+
+// Price calls f(item, schema).
+func (f ItemPricerFunc) Price(item InventoryItem, schema *Schema) (int, bool) {
+	return f(item, schema)
+}
+
+// ValueBackpack sums the price of each item, as returned by GetPlayerItems, using the pricer. Items which the
+// pricer could not price are returned in unpriced so callers can judge how complete the total is.
+func ValueBackpack(items []InventoryItem, schema *Schema, pricer ItemPricer) (int, []InventoryItem) {
+	var (
+		totalCents int
+		unpriced   []InventoryItem
+	)
+
+	for _, item := range items {
+		cents, ok := pricer.Price(item, schema)
+		if !ok {
+			unpriced = append(unpriced, item)
+
+			continue
+		}
+
+		totalCents += cents
+	}
+
+	return totalCents, unpriced
+}
@@ -0,0 +1,41 @@
+package steamweb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValueBackpack(t *testing.T) {
+	schema := newSchema(&SchemaOverview{}, []SchemaItem{
+		{DefIndex: 5021, Name: "Decoder Ring"},
+		{DefIndex: 5002, Name: "Refined Metal"},
+		{DefIndex: 30000, Name: "Unpriced Hat"},
+	})
+
+	item, found := schema.Item(5002)
+	require.True(t, found)
+	require.Equal(t, "Refined Metal", item.Name)
+
+	_, found = schema.Item(1)
+	require.False(t, found)
+
+	prices := map[string]int{"Decoder Ring": 199, "Refined Metal": 3}
+	pricer := ItemPricerFunc(func(item InventoryItem, schema *Schema) (int, bool) {
+		def, found := schema.Item(item.DefIndex)
+		if !found {
+			return 0, false
+		}
+
+		cents, ok := prices[def.Name]
+
+		return cents, ok
+	})
+
+	items := []InventoryItem{{ID: 1, DefIndex: 5021}, {ID: 2, DefIndex: 5002}, {ID: 3, DefIndex: 5002}, {ID: 4, DefIndex: 30000}, {ID: 5, DefIndex: 99999}}
+	total, unpriced := ValueBackpack(items, schema, pricer)
+	require.Equal(t, 205, total)
+	require.Len(t, unpriced, 2)
+	require.Equal(t, 4, unpriced[0].ID)
+	require.Equal(t, 5, unpriced[1].ID)
+}
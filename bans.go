@@ -0,0 +1,266 @@
+package steamweb
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/leighmacdonald/steamid/v4/steamid"
+)
+
+// Weights used to calculate BanReport.Severity.
+const (
+	severityVACBan        = 10
+	severityGameBan       = 5
+	severityEconBan       = 3
+	severityEconProbation = 1
+	severityCommunityBan  = 2
+	// severityRecent is added when the last ban happened within recentBanDays.
+	severityRecent = 5
+	recentBanDays  = 90
+)
+
+// BanReport is a moderation oriented summary of a players bans.
+type BanReport struct {
+	SteamID steamid.SteamID `json:"steam_id"`
+	// SteamID2 is the STEAM_0:X:Y formatted id.
+	SteamID2 steamid.SID `json:"steam_id2"`
+	// SteamID3 is the [U:1:X] formatted id.
+	SteamID3 steamid.SID3   `json:"steam_id3"`
+	Bans     PlayerBanState `json:"bans"`
+	// Severity is a relative score, higher is worse. Players without bans have a severity of 0.
+	Severity int `json:"severity"`
+	// Summary is a human-readable description of the bans, eg: VAC banned 12 days ago; 1 game ban.
+	Summary string `json:"summary"`
+}
+
+// PlayerBanReport fetches the bans for every player using GetPlayerBansMap and builds a BanReport for each.
+// Reports are ordered with banned players first, then by the most recent ban and finally by severity.
+// If any request fails, the reports for the players fetched successfully are returned along with the error.
+func PlayerBanReport(ctx context.Context, client HTTPClientHandler, steamIDs steamid.Collection) ([]BanReport, error) {
+	bans, errBans := GetPlayerBansMap(ctx, client, steamIDs)
+
+	reports := make([]BanReport, 0, len(bans))
+	for _, ban := range bans {
+		reports = append(reports, newBanReport(ban))
+	}
+
+	sortBanReports(reports)
+
+	return reports, errBans
+}
+
+func newBanReport(ban PlayerBanState) BanReport {
+	sid := ban.SteamID
+
+	return BanReport{
+		SteamID:  sid,
+		SteamID2: sid.Steam(false),
+		SteamID3: sid.Steam3(),
+		Bans:     ban,
+		Severity: banSeverity(ban),
+		Summary:  banSummary(ban),
+	}
+}
+
+func banSeverity(ban PlayerBanState) int {
+	severity := ban.NumberOfVACBans*severityVACBan + ban.NumberOfGameBans*severityGameBan
+
+	if ban.CommunityBanned {
+		severity += severityCommunityBan
+	}
+
+	switch ban.EconomyBan {
+	case EconBanBanned:
+		severity += severityEconBan
+	case EconBanProbation:
+		severity += severityEconProbation
+	case EconBanNone:
+	}
+
+	if _, found := ban.LastBan(); found && ban.DaysSinceLastBan <= recentBanDays {
+		severity += severityRecent
+	}
+
+	return severity
+}
+
+func banSummary(ban PlayerBanState) string {
+	var parts []string
+
+	switch {
+	case ban.NumberOfVACBans == 1:
+		parts = append(parts, "VAC banned")
+	case ban.NumberOfVACBans > 1:
+		parts = append(parts, fmt.Sprintf("%d VAC bans", ban.NumberOfVACBans))
+	}
+
+	switch {
+	case ban.NumberOfGameBans == 1:
+		parts = append(parts, "1 game ban")
+	case ban.NumberOfGameBans > 1:
+		parts = append(parts, fmt.Sprintf("%d game bans", ban.NumberOfGameBans))
+	}
+
+	// Days since last ban applies to the most recent of the vac and game bans.
+	if len(parts) > 0 {
+		switch ban.DaysSinceLastBan {
+		case 0:
+			parts[0] += " today"
+		case 1:
+			parts[0] += " 1 day ago"
+		default:
+			parts[0] += fmt.Sprintf(" %d days ago", ban.DaysSinceLastBan)
+		}
+	}
+
+	if ban.CommunityBanned {
+		parts = append(parts, "community banned")
+	}
+
+	switch ban.EconomyBan {
+	case EconBanBanned:
+		parts = append(parts, "trade banned")
+	case EconBanProbation:
+		parts = append(parts, "trade probation")
+	case EconBanNone:
+	}
+
+	if len(parts) == 0 {
+		return "No bans"
+	}
+
+	return strings.Join(parts, "; ")
+}
+
+func sortBanReports(reports []BanReport) {
+	sort.SliceStable(reports, func(i, j int) bool {
+		left, right := reports[i], reports[j]
+		leftBanned, rightBanned := left.Bans.IsBanned(), right.Bans.IsBanned()
+
+		if leftBanned != rightBanned {
+			return leftBanned
+		}
+
+		_, leftDated := left.Bans.LastBan()
+		_, rightDated := right.Bans.LastBan()
+
+		if leftDated != rightDated {
+			return leftDated
+		}
+
+		if leftDated && left.Bans.DaysSinceLastBan != right.Bans.DaysSinceLastBan {
+			return left.Bans.DaysSinceLastBan < right.Bans.DaysSinceLastBan
+		}
+
+		if left.Severity != right.Severity {
+			return left.Severity > right.Severity
+		}
+
+		return left.SteamID.Int64() < right.SteamID.Int64()
+	})
+}
+
+// BanType is a kind of ban reported by BanDiff.
+type BanType int
+
+// BanType options
+//
+//goland:noinspection ALL
+const (
+	BanTypeVAC BanType = iota
+	BanTypeGame
+	BanTypeCommunity
+	BanTypeEconomy
+)
+
+func (t BanType) String() string {
+	switch t {
+	case BanTypeVAC:
+		return "vac"
+	case BanTypeGame:
+		return "game"
+	case BanTypeCommunity:
+		return "community"
+	case BanTypeEconomy:
+		return "economy"
+	default:
+		return fmt.Sprintf("unknown(%d)", int(t))
+	}
+}
+
+// BanChange is a ban applied to a player between two snapshots compared by BanDiff.
+type BanChange struct {
+	SteamID steamid.SteamID `json:"steam_id"`
+	Type    BanType         `json:"type"`
+	// Added is the number of new bans of the type. It is always 1 for community and economy bans.
+	Added    int            `json:"added"`
+	Previous PlayerBanState `json:"previous"`
+	Current  PlayerBanState `json:"current"`
+}
+
+// econBanRank orders economy ban states by severity so that only escalations are reported.
+func econBanRank(state EconBanState) int {
+	switch state {
+	case EconBanBanned:
+		return 2
+	case EconBanProbation:
+		return 1
+	case EconBanNone:
+	}
+
+	return 0
+}
+
+// BanDiff compares two snapshots of the same players, such as two results of GetPlayerBans or GetPlayerBansMap,
+// and reports every ban applied since the old snapshot. Bans already present in the old snapshot are not
+// reported, but additional bans of the same type are, eg: a second VAC ban. An economy probation escalating to an
+// economy ban is reported, lifted bans are not. Players missing from the old snapshot have no baseline and are
+// skipped. Changes are ordered by their position in current, then by BanType.
+func BanDiff(previous, current []PlayerBanState) []BanChange {
+	before := make(map[steamid.SteamID]PlayerBanState, len(previous))
+	for _, state := range previous {
+		before[state.SteamID] = state
+	}
+
+	var changes []BanChange
+
+	for _, now := range current {
+		old, found := before[now.SteamID]
+		if !found {
+			continue
+		}
+
+		change := func(banType BanType, added int) {
+			changes = append(changes, BanChange{
+				SteamID:  now.SteamID,
+				Type:     banType,
+				Added:    added,
+				Previous: old,
+				Current:  now,
+			})
+		}
+
+		if added := now.NumberOfVACBans - old.NumberOfVACBans; added > 0 {
+			change(BanTypeVAC, added)
+		} else if now.VACBanned && !old.VACBanned {
+			// The count may lag behind the flag, the flag flipping is still a new ban.
+			change(BanTypeVAC, 1)
+		}
+
+		if added := now.NumberOfGameBans - old.NumberOfGameBans; added > 0 {
+			change(BanTypeGame, added)
+		}
+
+		if now.CommunityBanned && !old.CommunityBanned {
+			change(BanTypeCommunity, 1)
+		}
+
+		if econBanRank(now.EconomyBan) > econBanRank(old.EconomyBan) {
+			change(BanTypeEconomy, 1)
+		}
+	}
+
+	return changes
+}
@@ -0,0 +1,74 @@
+package steamweb
+
+import (
+	"testing"
+
+	"github.com/leighmacdonald/steamid/v4/steamid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBanSummary(t *testing.T) {
+	require.Equal(t, "No bans", banSummary(PlayerBanState{EconomyBan: EconBanNone}))
+	require.Equal(t, "VAC banned 12 days ago; 1 game ban", banSummary(PlayerBanState{
+		VACBanned: true, NumberOfVACBans: 1, NumberOfGameBans: 1, DaysSinceLastBan: 12,
+	}))
+	require.Equal(t, "2 game bans today; community banned; trade banned", banSummary(PlayerBanState{
+		NumberOfGameBans: 2, CommunityBanned: true, EconomyBan: EconBanBanned,
+	}))
+}
+
+func TestSortBanReports(t *testing.T) {
+	clean := newBanReport(PlayerBanState{SteamID: steamid.New(76561197960287930), EconomyBan: EconBanNone})
+	old := newBanReport(PlayerBanState{
+		SteamID: steamid.New(76561197960287931), VACBanned: true, NumberOfVACBans: 1, DaysSinceLastBan: 1000,
+	})
+	recent := newBanReport(PlayerBanState{
+		SteamID: steamid.New(76561197960287932), NumberOfGameBans: 1, DaysSinceLastBan: 3,
+	})
+	community := newBanReport(PlayerBanState{SteamID: steamid.New(76561197960287933), CommunityBanned: true})
+
+	require.Zero(t, clean.Severity)
+	require.Greater(t, recent.Severity, severityGameBan)
+	require.Equal(t, severityVACBan, old.Severity)
+	require.Equal(t, "STEAM_0:0:11101", string(clean.SteamID2))
+
+	reports := []BanReport{clean, community, old, recent}
+	sortBanReports(reports)
+
+	require.Equal(t, []steamid.SteamID{recent.SteamID, old.SteamID, community.SteamID, clean.SteamID},
+		[]steamid.SteamID{reports[0].SteamID, reports[1].SteamID, reports[2].SteamID, reports[3].SteamID})
+}
+
+func TestBanDiff(t *testing.T) {
+	sidA := steamid.New(76561197960265729)
+	sidB := steamid.New(76561197960265730)
+	sidC := steamid.New(76561197960265731)
+	sidD := steamid.New(76561197960265732)
+
+	previous := []PlayerBanState{
+		{SteamID: sidA, VACBanned: true, NumberOfVACBans: 1, EconomyBan: EconBanNone},
+		{SteamID: sidB, EconomyBan: EconBanProbation},
+		{SteamID: sidC, CommunityBanned: true, NumberOfGameBans: 1, EconomyBan: EconBanBanned},
+	}
+	current := []PlayerBanState{
+		{SteamID: sidA, VACBanned: true, NumberOfVACBans: 2, NumberOfGameBans: 1, EconomyBan: EconBanNone},
+		{SteamID: sidB, CommunityBanned: true, EconomyBan: EconBanBanned},
+		{SteamID: sidC, CommunityBanned: true, NumberOfGameBans: 1, EconomyBan: EconBanProbation},
+		{SteamID: sidD, VACBanned: true, NumberOfVACBans: 1},
+	}
+
+	changes := BanDiff(previous, current)
+	require.Len(t, changes, 4)
+
+	require.Equal(t, sidA, changes[0].SteamID)
+	require.Equal(t, BanTypeVAC, changes[0].Type)
+	require.Equal(t, 1, changes[0].Added)
+	require.Equal(t, BanTypeGame, changes[1].Type)
+
+	require.Equal(t, sidB, changes[2].SteamID)
+	require.Equal(t, BanTypeCommunity, changes[2].Type)
+	require.Equal(t, BanTypeEconomy, changes[3].Type)
+	require.Equal(t, EconBanProbation, changes[3].Previous.EconomyBan)
+
+	require.Empty(t, BanDiff(current, current))
+}
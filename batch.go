@@ -0,0 +1,414 @@
+package steamweb
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/leighmacdonald/steamid/v4/steamid"
+	"github.com/pkg/errors"
+	"golang.org/x/time/rate"
+)
+
+// maxConcurrentRequests limits how many requests the batch helpers will have in flight at once. Their request
+// rate is limited separately by batchLimiter.
+const maxConcurrentRequests = 5
+
+// defaultBatchRequestRate allows the batch helpers to start 10 requests per second.
+const defaultBatchRequestRate = rate.Limit(10)
+
+// batchLimiter throttles the requests made by the batch and paging helpers. It is shared by all of them so that
+// running several at once does not multiply the request rate.
+var batchLimiter = rate.NewLimiter(defaultBatchRequestRate, maxConcurrentRequests) //nolint:gochecknoglobals
+
+// SetBatchRequestRate sets the maximum requests per second started by the helpers which make many requests, such as
+// GetOwnedGamesMulti, GetRecentlyPlayedGamesMulti, ResolveVanityURLs and the pages of QueryFilesAll. The limit is
+// shared between all of them and is independent of any rate limiting done by the HTTPClientHandler. A value <= 0
+// restores the default of 10.
+func SetBatchRequestRate(rps float64) {
+	limit := rate.Limit(rps)
+	if rps <= 0 {
+		limit = defaultBatchRequestRate
+	}
+
+	batchLimiter.SetLimit(limit)
+}
+
+// fanOut calls fn once for every unique key, with at most limit calls in flight at any time and calls started no
+// faster than batchLimiter allows. Successful results and errors are returned separately, keyed by their input. Keys
+// that were never started because the context was cancelled have the context error recorded.
+func fanOut[K comparable, V any](ctx context.Context, keys []K, limit int,
+	fn func(ctx context.Context, key K) (V, error),
+) (map[K]V, map[K]error) {
+	var (
+		results   = make(map[K]V, len(keys))
+		errs      = map[K]error{}
+		seen      = make(map[K]bool, len(keys))
+		resultsMu sync.Mutex
+		waitGroup sync.WaitGroup
+		sem       = make(chan struct{}, max(limit, 1))
+	)
+
+	for _, key := range keys {
+		if seen[key] {
+			continue
+		}
+
+		seen[key] = true
+
+		select {
+		case <-ctx.Done():
+			resultsMu.Lock()
+			errs[key] = ctx.Err()
+			resultsMu.Unlock()
+
+			continue
+		case sem <- struct{}{}:
+		}
+
+		waitGroup.Add(1)
+
+		go func(key K) {
+			defer func() {
+				<-sem
+				waitGroup.Done()
+			}()
+
+			var value V
+
+			err := batchLimiter.Wait(ctx)
+			if err == nil {
+				value, err = fn(ctx, key)
+			}
+
+			resultsMu.Lock()
+			defer resultsMu.Unlock()
+
+			if err != nil {
+				errs[key] = err
+
+				return
+			}
+
+			results[key] = value
+		}(key)
+	}
+
+	waitGroup.Wait()
+
+	return results, errs
+}
+
+// ResolveVanityURLs resolves multiple vanity names or profile urls concurrently using ResolveVanityURL. Successful
+// lookups and per-query errors are returned separately, keyed by the original query. Duplicate queries are
+// only resolved once and successful lookups are cached, see SetProfileCacheTTL, so repeated names are free.
+// Names that do not belong to any profile fail with ErrVanityNotFound.
+func ResolveVanityURLs(ctx context.Context, client HTTPClientHandler, queries []string) (map[string]steamid.SteamID, map[string]error) {
+	return fanOut(ctx, queries, maxConcurrentRequests, func(ctx context.Context, query string) (steamid.SteamID, error) {
+		key := newCacheKey(cacheKeyVanity, strings.TrimSpace(query))
+		if sid, found := getCached[steamid.SteamID](ctx, cacheFrom(ctx), key); found {
+			return sid, nil
+		}
+
+		sid, errResolve := ResolveVanityURL(ctx, client, query)
+		if errResolve != nil {
+			return steamid.SteamID{}, errResolve
+		}
+
+		if !sid.Valid() {
+			return steamid.SteamID{}, errors.Wrap(ErrVanityNotFound, query)
+		}
+
+		cacheFrom(ctx).set(key, sid, cacheTTL(ctx, profileTTL()))
+
+		return sid, nil
+	})
+}
+
+// Chunk splits ids into consecutive collections of at most size elements, for example to stay within the
+// maximum number of ids accepted per request. A size less than 1 returns all ids in a single chunk. An empty
+// collection returns no chunks.
+func Chunk(ids steamid.Collection, size int) []steamid.Collection {
+	var chunks []steamid.Collection
+
+	if size < 1 {
+		size = len(ids)
+	}
+
+	for size < len(ids) {
+		ids, chunks = ids[size:], append(chunks, ids[0:size:size])
+	}
+
+	if len(ids) > 0 {
+		chunks = append(chunks, ids)
+	}
+
+	return chunks
+}
+
+// Dedup returns ids with any duplicate entries removed, preserving the original order.
+func Dedup(ids steamid.Collection) steamid.Collection {
+	seen := make(map[steamid.SteamID]bool, len(ids))
+	unique := make(steamid.Collection, 0, len(ids))
+
+	for _, sid := range ids {
+		if seen[sid] {
+			continue
+		}
+
+		seen[sid] = true
+
+		unique = append(unique, sid)
+	}
+
+	return unique
+}
+
+// FilterValidIDs splits ids into those that are valid and those that are not, preserving their order. This allows
+// dropping malformed ids before spending requests on them while still reporting which inputs were invalid.
+func FilterValidIDs(ids steamid.Collection) (steamid.Collection, steamid.Collection) {
+	var valid, invalid steamid.Collection
+
+	for _, sid := range ids {
+		if sid.Valid() {
+			valid = append(valid, sid)
+		} else {
+			invalid = append(invalid, sid)
+		}
+	}
+
+	return valid, invalid
+}
+
+// GetPlayerBansMap fetches the ban state for any number of steam ids. The ids are split into chunks of 100 which
+// are fetched concurrently and the results are keyed by steam id. Invalid ids are skipped. Any ids missing from
+// the results were invalid or not returned by steam. If any chunk fails, the results that were fetched
+// successfully are returned along with the error.
+func GetPlayerBansMap(ctx context.Context, client HTTPClientHandler, steamIDs steamid.Collection) (map[steamid.SteamID]PlayerBanState, error) {
+	valid, _ := FilterValidIDs(steamIDs)
+	chunks := Chunk(Dedup(valid), maxSteamIDsPerRequest)
+	indexes := make([]int, len(chunks))
+
+	for index := range chunks {
+		indexes[index] = index
+	}
+
+	results, errs := fanOut(ctx, indexes, maxConcurrentRequests, func(ctx context.Context, index int) ([]PlayerBanState, error) {
+		return GetPlayerBans(ctx, client, chunks[index])
+	})
+
+	bans := make(map[steamid.SteamID]PlayerBanState, len(steamIDs))
+
+	for _, chunk := range results {
+		for _, ban := range chunk {
+			bans[ban.SteamID] = ban
+		}
+	}
+
+	for _, index := range indexes {
+		if err, found := errs[index]; found {
+			return bans, err
+		}
+	}
+
+	return bans, nil
+}
+
+// PlayerSummariesOptions controls how PlayerSummariesAll spends requests.
+type PlayerSummariesOptions struct {
+	// Concurrency is the number of chunks fetched at once. Defaults to 5.
+	Concurrency int
+	// Limit caps the number of ids resolved, counted after invalid and duplicate ids are removed, eg: a limit
+	// of 500 resolves the first 500 unique valid ids using 5 requests. Defaults to 0, no limit.
+	Limit int
+}
+
+// PlayerSummariesAll fetches the summaries of any number of steam ids using PlayerSummaries. Invalid and duplicate
+// ids are dropped and the remaining ids are split into chunks of 100 which are fetched concurrently. Summaries
+// are returned in chunk order. If any chunk fails, the summaries that were fetched successfully are returned
+// along with the error. A nil opts uses the defaults.
+func PlayerSummariesAll(ctx context.Context, client HTTPClientHandler, steamIDs steamid.Collection,
+	opts *PlayerSummariesOptions,
+) ([]PlayerSummary, error) {
+	if opts == nil {
+		opts = &PlayerSummariesOptions{}
+	}
+
+	concurrency := opts.Concurrency
+	if concurrency <= 0 {
+		concurrency = maxConcurrentRequests
+	}
+
+	valid, _ := FilterValidIDs(steamIDs)
+	unique := Dedup(valid)
+
+	if opts.Limit > 0 && len(unique) > opts.Limit {
+		unique = unique[:opts.Limit]
+	}
+
+	chunks := Chunk(unique, maxSteamIDsPerRequest)
+	indexes := make([]int, len(chunks))
+
+	for index := range chunks {
+		indexes[index] = index
+	}
+
+	results, errs := fanOut(ctx, indexes, concurrency, func(ctx context.Context, index int) ([]PlayerSummary, error) {
+		return PlayerSummaries(ctx, client, chunks[index])
+	})
+
+	summaries := make([]PlayerSummary, 0, len(unique))
+
+	for _, index := range indexes {
+		summaries = append(summaries, results[index]...)
+	}
+
+	for _, index := range indexes {
+		if err, found := errs[index]; found {
+			return summaries, err
+		}
+	}
+
+	return summaries, nil
+}
+
+// GetNewsForApps fetches the news for multiple apps concurrently using GetNewsForApp, keyed by app id. If any
+// request fails, the results that were fetched successfully are returned along with the error.
+func GetNewsForApps(ctx context.Context, client HTTPClientHandler, appIDs []steamid.AppID, opts *GetNewsForAppOptions) (map[steamid.AppID][]NewsItem, error) {
+	news, errs := fanOut(ctx, appIDs, maxConcurrentRequests, func(ctx context.Context, appID steamid.AppID) ([]NewsItem, error) {
+		return GetNewsForApp(ctx, client, appID, opts)
+	})
+
+	for _, appID := range appIDs {
+		if err, found := errs[appID]; found {
+			return news, err
+		}
+	}
+
+	return news, nil
+}
+
+// GetOwnedGamesMulti fetches the owned games of multiple users concurrently using GetOwnedGames, keyed by steam id.
+// Requests are limited to the rate set with SetBatchRequestRate. Users with private game details have
+// ErrProfilePrivate recorded in the error map.
+func GetOwnedGamesMulti(ctx context.Context, client HTTPClientHandler, steamIDs steamid.Collection) (map[steamid.SteamID][]OwnedGame, map[steamid.SteamID]error) {
+	return fanOut(ctx, steamIDs, maxConcurrentRequests, func(ctx context.Context, sid steamid.SteamID) ([]OwnedGame, error) {
+		games, private, errGames := getOwnedGames(ctx, client, sid, nil)
+		if errGames != nil {
+			return nil, errGames
+		}
+
+		if private {
+			return nil, ErrProfilePrivate
+		}
+
+		return games, nil
+	})
+}
+
+// CommonGames returns the app ids owned by every user in results, such as those returned by GetOwnedGamesMulti,
+// sorted in ascending order. An empty results map returns no games.
+func CommonGames(results map[steamid.SteamID][]OwnedGame) []steamid.AppID {
+	counts := map[steamid.AppID]int{}
+
+	for _, games := range results {
+		owned := make(map[steamid.AppID]bool, len(games))
+
+		for _, game := range games {
+			if !owned[game.AppID] {
+				owned[game.AppID] = true
+				counts[game.AppID]++
+			}
+		}
+	}
+
+	common := make([]steamid.AppID, 0, len(counts))
+
+	for appID, count := range counts {
+		if count == len(results) {
+			common = append(common, appID)
+		}
+	}
+
+	slices.Sort(common)
+
+	return common
+}
+
+// GetRecentlyPlayedGamesMulti fetches the recently played games of multiple users concurrently using
+// GetRecentlyPlayedGames, keyed by steam id. Requests share the rate limit set with SetBatchRequestRate. Users
+// with private game details have ErrProfilePrivate recorded in the error map, users that have not played anything
+// recently have an empty slice.
+func GetRecentlyPlayedGamesMulti(ctx context.Context, client HTTPClientHandler, steamIDs steamid.Collection) (map[steamid.SteamID][]RecentGame, map[steamid.SteamID]error) {
+	return fanOut(ctx, steamIDs, maxConcurrentRequests, func(ctx context.Context, sid steamid.SteamID) ([]RecentGame, error) {
+		games, private, errGames := getRecentlyPlayedGames(ctx, client, sid)
+		if errGames != nil {
+			return nil, errGames
+		}
+
+		if private {
+			return nil, ErrProfilePrivate
+		}
+
+		if games == nil {
+			games = []RecentGame{}
+		}
+
+		return games, nil
+	})
+}
+
+// TrendingGame is a game played by a group of users, as returned by TrendingAmong.
+type TrendingGame struct {
+	AppID steamid.AppID `json:"appid"`
+	Name  string        `json:"name"`
+	// Players is the number of users in the group that played the game recently.
+	Players int `json:"players"`
+	// Playtime2Weeks is the combined playtime, in minutes, of the group over the last two weeks.
+	Playtime2Weeks int `json:"playtime_2weeks"`
+}
+
+// TrendingAmong aggregates results, such as those returned by GetRecentlyPlayedGamesMulti, into the games played
+// by the group. Games are ordered by the number of players, then by the combined playtime and finally by app id.
+func TrendingAmong(results map[steamid.SteamID][]RecentGame) []TrendingGame {
+	byApp := map[steamid.AppID]*TrendingGame{}
+
+	for _, games := range results {
+		counted := make(map[steamid.AppID]bool, len(games))
+
+		for _, game := range games {
+			trending, found := byApp[game.AppID]
+			if !found {
+				trending = &TrendingGame{AppID: game.AppID, Name: game.Name}
+				byApp[game.AppID] = trending
+			}
+
+			if !counted[game.AppID] {
+				counted[game.AppID] = true
+				trending.Players++
+			}
+
+			trending.Playtime2Weeks += game.Playtime2Weeks
+		}
+	}
+
+	trending := make([]TrendingGame, 0, len(byApp))
+	for _, game := range byApp {
+		trending = append(trending, *game)
+	}
+
+	slices.SortFunc(trending, func(a, b TrendingGame) int {
+		if a.Players != b.Players {
+			return b.Players - a.Players
+		}
+
+		if a.Playtime2Weeks != b.Playtime2Weeks {
+			return b.Playtime2Weeks - a.Playtime2Weeks
+		}
+
+		return int(a.AppID) - int(b.AppID)
+	})
+
+	return trending
+}
@@ -0,0 +1,247 @@
+package steamweb
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// defaultCacheTTL is how long cached static results are considered valid.
+	defaultCacheTTL = time.Hour * 6
+	// defaultProfileCacheTTL is how long cached user profile data is considered valid, see SetProfileCacheTTL.
+	defaultProfileCacheTTL = time.Minute * 5
+	// cacheSweepInterval is the minimum time between removing expired entries from the cache.
+	cacheSweepInterval = time.Minute
+)
+
+// cacheKey identifies a cached result. Results that depend on parameters such as a steam id are keyed
+// using newCacheKey.
+type cacheKey string
+
+const (
+	cacheKeyAppList          cacheKey = "applist"
+	cacheKeyAppIndex         cacheKey = "appindex"
+	cacheKeySupportedAPIList cacheKey = "supportedapilist"
+	cacheKeyProfileItems     cacheKey = "profileitems"
+	cacheKeySchemaItems      cacheKey = "schemaitems"
+	cacheKeySchemaOverview   cacheKey = "schemaoverview"
+	cacheKeySchemaURL        cacheKey = "schemaurl"
+	cacheKeyStoreMetaData    cacheKey = "storemetadata"
+	cacheKeyGameStatsSchema  cacheKey = "gamestatsschema"
+	cacheKeyAssetClass       cacheKey = "assetclass"
+	cacheKeyOwnedGames       cacheKey = "ownedgames"
+	cacheKeyPublishedFile    cacheKey = "publishedfile"
+	cacheKeyVanity           cacheKey = "vanity"
+)
+
+// newCacheKey builds a composite key from the base key and parameters, eg: profileitems:76561197961279983.
+func newCacheKey(key cacheKey, params ...any) cacheKey {
+	parts := make([]string, len(params)+1)
+	parts[0] = string(key)
+
+	for i, param := range params {
+		parts[i+1] = strings.ToLower(fmt.Sprintf("%v", param))
+	}
+
+	return cacheKey(strings.Join(parts, ":"))
+}
+
+var cache = newMemoryCache() //nolint:gochecknoglobals
+
+var (
+	profileTTLMu    sync.RWMutex             //nolint:gochecknoglobals
+	profileCacheTTL = defaultProfileCacheTTL //nolint:gochecknoglobals
+)
+
+// SetProfileCacheTTL sets how long user profile data, such as the results of GetOwnedGames and
+// GetProfileItemsEquipped, is cached. A ttl set on the context with WithCacheTTL still takes precedence. A ttl of
+// 0 or less restores the default of 5 minutes.
+func SetProfileCacheTTL(ttl time.Duration) {
+	if ttl <= 0 {
+		ttl = defaultProfileCacheTTL
+	}
+
+	profileTTLMu.Lock()
+	profileCacheTTL = ttl
+	profileTTLMu.Unlock()
+}
+
+// profileTTL returns the ttl set with SetProfileCacheTTL.
+func profileTTL() time.Duration {
+	profileTTLMu.RLock()
+	defer profileTTLMu.RUnlock()
+
+	return profileCacheTTL
+}
+
+// CacheEntryInfo describes the state of a single populated cache entry.
+type CacheEntryInfo struct {
+	Key string
+	// Age is how long ago the entry was stored.
+	Age time.Duration
+	TTL time.Duration
+	// Expired entries will be refreshed on the next request.
+	Expired bool
+	// Size is the number of elements for slice and map values, 1 otherwise.
+	Size int
+}
+
+// CacheEntries returns the state of every populated cache entry, sorted by key.
+func CacheEntries() []CacheEntryInfo {
+	return cache.entries()
+}
+
+// AppListAge returns how long ago the app list served by GetAppList was fetched. False is returned when the list
+// is not cached or has expired, in which case the next GetAppList call fetches it again. The age of every other
+// cached result is available from CacheEntries.
+func AppListAge() (time.Duration, bool) {
+	return cache.age(cacheKeyAppList)
+}
+
+// ClearCache removes all cached results, forcing them to be fetched again on the next request.
+func ClearCache() {
+	cache.clear()
+}
+
+// cacheValue wraps a cached value with its expiry information.
+type cacheValue struct {
+	value   any
+	created time.Time
+	ttl     time.Duration
+}
+
+func (v cacheValue) expired() bool {
+	return time.Since(v.created) > v.ttl
+}
+
+// memoryCache is a simple in memory ttl cache used for results that rarely change.
+type memoryCache struct {
+	mu     sync.RWMutex
+	values map[cacheKey]cacheValue
+	// lastSweep is when expired entries were last removed, see sweep.
+	lastSweep     time.Time
+	sweepInterval time.Duration
+	// appListRefresh ensures only a single StartAppListRefresh refresh of this cache runs at a time.
+	appListRefresh sync.Mutex
+}
+
+func newMemoryCache() *memoryCache {
+	return &memoryCache{values: map[cacheKey]cacheValue{}, lastSweep: time.Now(), sweepInterval: cacheSweepInterval}
+}
+
+// set stores the value under the key until the ttl expires. Expired entries are periodically removed when
+// setting a value so that per user keys do not accumulate forever in long-running processes.
+func (c *memoryCache) set(key cacheKey, value any, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.sweep()
+
+	c.values[key] = cacheValue{value: value, created: time.Now(), ttl: ttl}
+}
+
+// sweep removes every expired entry, at most once per sweepInterval. The caller must hold the write lock.
+func (c *memoryCache) sweep() {
+	if time.Since(c.lastSweep) < c.sweepInterval {
+		return
+	}
+
+	for key, cached := range c.values {
+		if cached.expired() {
+			delete(c.values, key)
+		}
+	}
+
+	c.lastSweep = time.Now()
+}
+
+// get returns the value stored under the key, if it exists and has not expired.
+func (c *memoryCache) get(key cacheKey) (any, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	cached, found := c.values[key]
+	if !found || cached.expired() {
+		return nil, false
+	}
+
+	return cached.value, true
+}
+
+// age returns how long ago the value stored under the key was set, if it exists and has not expired.
+func (c *memoryCache) age(key cacheKey) (time.Duration, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	cached, found := c.values[key]
+	if !found || cached.expired() {
+		return 0, false
+	}
+
+	return time.Since(cached.created), true
+}
+
+// getCached returns the cached value for the key as type T. A value of the wrong type is treated as a miss, as
+// is any lookup using a context created with WithCacheBypass.
+func getCached[T any](ctx context.Context, c *memoryCache, key cacheKey) (T, bool) {
+	var empty T
+
+	if cacheBypassed(ctx) {
+		return empty, false
+	}
+
+	value, found := c.get(key)
+	if !found {
+		return empty, false
+	}
+
+	typed, ok := value.(T)
+	if !ok {
+		return empty, false
+	}
+
+	return typed, true
+}
+
+// entries returns the state of every value in the cache, sorted by key.
+func (c *memoryCache) entries() []CacheEntryInfo {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	entries := make([]CacheEntryInfo, 0, len(c.values))
+
+	for key, cached := range c.values {
+		size := 1
+
+		if value := reflect.ValueOf(cached.value); value.Kind() == reflect.Slice || value.Kind() == reflect.Map {
+			size = value.Len()
+		}
+
+		entries = append(entries, CacheEntryInfo{
+			Key:     string(key),
+			Age:     time.Since(cached.created),
+			TTL:     cached.ttl,
+			Expired: cached.expired(),
+			Size:    size,
+		})
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Key < entries[j].Key
+	})
+
+	return entries
+}
+
+// clear removes all values from the cache.
+func (c *memoryCache) clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.values = map[cacheKey]cacheValue{}
+}
@@ -0,0 +1,123 @@
+package steamweb
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemoryCache(t *testing.T) {
+	testCache := newMemoryCache()
+
+	_, found := getCached[[]App](context.Background(), testCache, cacheKeyAppList)
+	require.False(t, found)
+
+	apps := []App{{AppID: 440, Name: "Team Fortress 2"}}
+	testCache.set(cacheKeyAppList, apps, time.Minute)
+
+	cached, found := getCached[[]App](context.Background(), testCache, cacheKeyAppList)
+	require.True(t, found)
+	require.Equal(t, apps, cached)
+
+	_, foundWrongType := getCached[[]SupportedAPIInterfaces](context.Background(), testCache, cacheKeyAppList)
+	require.False(t, foundWrongType)
+
+	testCache.set(cacheKeySupportedAPIList, []SupportedAPIInterfaces{}, time.Millisecond)
+	time.Sleep(time.Millisecond * 5)
+
+	_, foundExpired := getCached[[]SupportedAPIInterfaces](context.Background(), testCache, cacheKeySupportedAPIList)
+	require.False(t, foundExpired)
+}
+
+func TestMemoryCacheGetReturnsValue(t *testing.T) {
+	testCache := newMemoryCache()
+	testCache.set(cacheKeyAppList, "value", time.Minute)
+
+	value, found := testCache.get(cacheKeyAppList)
+	require.True(t, found)
+	require.IsType(t, "", value)
+	require.Equal(t, "value", value)
+
+	testCache.set(cacheKeyAppList, "expired", -time.Second)
+
+	expired, foundExpired := testCache.get(cacheKeyAppList)
+	require.False(t, foundExpired)
+	require.Nil(t, expired)
+}
+
+func TestMemoryCacheEntries(t *testing.T) {
+	testCache := newMemoryCache()
+	testCache.set(cacheKeySupportedAPIList, []SupportedAPIInterfaces{{Name: "ISteamUser"}}, time.Minute)
+	testCache.set(cacheKeyAppList, []App{{AppID: 440}, {AppID: 730}}, time.Minute)
+
+	entries := testCache.entries()
+	require.Len(t, entries, 2)
+	require.Equal(t, "applist", entries[0].Key)
+	require.Equal(t, 2, entries[0].Size)
+	require.Equal(t, time.Minute, entries[0].TTL)
+	require.False(t, entries[0].Expired)
+	require.Equal(t, "supportedapilist", entries[1].Key)
+
+	testCache.clear()
+	require.Empty(t, testCache.entries())
+}
+
+func TestNewCacheKey(t *testing.T) {
+	require.Equal(t, cacheKeyAppList, newCacheKey(cacheKeyAppList))
+	require.Equal(t, cacheKey("profileitems:76561197961279983"), newCacheKey(cacheKeyProfileItems, "76561197961279983"))
+	require.Equal(t, cacheKey("profileitems:440:en_us"), newCacheKey(cacheKeyProfileItems, 440, "en_US"))
+}
+
+func TestGetCachedBypass(t *testing.T) {
+	testCache := newMemoryCache()
+	testCache.set(cacheKeySchemaURL, "url", time.Minute)
+
+	cached, found := getCached[string](context.Background(), testCache, cacheKeySchemaURL)
+	require.True(t, found)
+	require.Equal(t, "url", cached)
+
+	_, foundBypass := getCached[string](WithCacheBypass(context.Background()), testCache, cacheKeySchemaURL)
+	require.False(t, foundBypass)
+}
+
+func TestMemoryCacheAge(t *testing.T) {
+	testCache := newMemoryCache()
+
+	_, found := testCache.age(cacheKeyAppList)
+	require.False(t, found)
+
+	testCache.set(cacheKeyAppList, []App{}, time.Minute)
+	time.Sleep(time.Millisecond * 5)
+
+	age, found := testCache.age(cacheKeyAppList)
+	require.True(t, found)
+	require.GreaterOrEqual(t, age, time.Millisecond*5)
+	require.Less(t, age, time.Minute)
+
+	testCache.set(cacheKeyAppList, []App{}, time.Millisecond)
+	time.Sleep(time.Millisecond * 5)
+
+	_, foundExpired := testCache.age(cacheKeyAppList)
+	require.False(t, foundExpired)
+}
+
+func TestMemoryCacheSweep(t *testing.T) {
+	testCache := newMemoryCache()
+	testCache.set(newCacheKey(cacheKeyOwnedGames, 76561197961279983), ownedGamesResult{}, time.Millisecond)
+	testCache.set(cacheKeyAppList, []App{}, time.Minute)
+	time.Sleep(time.Millisecond * 5)
+
+	// Sweeps are rate limited, so the expired entry remains until the interval passes.
+	testCache.set(cacheKeySchemaURL, "url", time.Minute)
+	require.Len(t, testCache.entries(), 3)
+
+	testCache.sweepInterval = 0
+	testCache.set(cacheKeySchemaURL, "url", time.Minute)
+
+	entries := testCache.entries()
+	require.Len(t, entries, 2)
+	require.Equal(t, "applist", entries[0].Key)
+	require.Equal(t, "schemaurl", entries[1].Key)
+}
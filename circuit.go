@@ -0,0 +1,144 @@
+package steamweb
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// ErrCircuitOpen is returned without performing a request when the circuit breaker for the endpoint is open
+// after too many consecutive failures. See SetCircuitBreaker.
+var ErrCircuitOpen = errors.New("Circuit breaker open")
+
+// circuitState tracks the consecutive failures of a single endpoint.
+type circuitState struct {
+	failures  int
+	openUntil time.Time
+	// probing is set while the single request allowed through after the cooldown, the half-open probe, is in flight.
+	probing bool
+}
+
+// circuitKey identifies the circuit of an endpoint for a single api key, so that one key being rate limited does
+// not block requests made by a Client using a different key.
+type circuitKey struct {
+	apiKey string
+	path   string
+}
+
+type circuitBreaker struct {
+	mu        sync.Mutex
+	threshold int
+	cooldown  time.Duration
+	endpoints map[circuitKey]*circuitState
+}
+
+var circuit = &circuitBreaker{endpoints: map[circuitKey]*circuitState{}} //nolint:gochecknoglobals
+
+// SetCircuitBreaker enables a circuit breaker for each endpoint and api key. After failures consecutive retryable
+// failures, as reported by IsRetryable, requests to that endpoint fail immediately with ErrCircuitOpen until the
+// cooldown has passed. A single probe request is then let through while any concurrent requests continue to fail
+// with ErrCircuitOpen. The circuit closes if the probe succeeds and opens again if it also fails. A failures value
+// of 0 or less disables the circuit breaker, which is the default. Each Client has separate circuits for its own
+// key using the same settings.
+func SetCircuitBreaker(failures int, cooldown time.Duration) {
+	circuit.mu.Lock()
+	defer circuit.mu.Unlock()
+
+	circuit.threshold = failures
+	circuit.cooldown = cooldown
+	circuit.endpoints = map[circuitKey]*circuitState{}
+}
+
+// OpenCircuits returns the endpoint paths that currently have an open circuit for the package level key, sorted.
+func OpenCircuits() []string {
+	return circuit.open(Key())
+}
+
+// open returns the endpoint paths that currently have an open circuit for the api key, sorted.
+func (c *circuitBreaker) open(apiKey string) []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var open []string
+
+	now := time.Now()
+
+	for key, state := range c.endpoints {
+		if key.apiKey == apiKey && now.Before(state.openUntil) {
+			open = append(open, key.path)
+		}
+	}
+
+	sort.Strings(open)
+
+	return open
+}
+
+// allow returns ErrCircuitOpen if the circuit for the path and api key is open.
+func (c *circuitBreaker) allow(apiKey string, path string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.threshold <= 0 {
+		return nil
+	}
+
+	state, found := c.endpoints[circuitKey{apiKey: apiKey, path: normalizeEndpointPath(path)}]
+	if !found || state.failures < c.threshold {
+		return nil
+	}
+
+	if state.probing || time.Now().Before(state.openUntil) {
+		return errors.Wrap(ErrCircuitOpen, path)
+	}
+
+	state.probing = true
+
+	return nil
+}
+
+// record updates the state of the circuit for the path and api key with the result of a request.
+func (c *circuitBreaker) record(apiKey string, path string, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.threshold <= 0 {
+		return
+	}
+
+	key := circuitKey{apiKey: apiKey, path: normalizeEndpointPath(path)}
+
+	if err == nil {
+		delete(c.endpoints, key)
+
+		return
+	}
+
+	state, found := c.endpoints[key]
+
+	// Errors such as a private profile or a cancelled context say nothing about the health of the endpoint, so
+	// another probe is allowed.
+	if !IsRetryable(err) {
+		if found {
+			state.probing = false
+		}
+
+		return
+	}
+
+	if !found {
+		state = &circuitState{}
+		c.endpoints[key] = state
+	}
+
+	state.probing = false
+	state.failures++
+
+	if state.failures >= c.threshold {
+		state.openUntil = time.Now().Add(c.cooldown)
+
+		debugf("Circuit opened for %s after %d failures: %v\n", key.path, state.failures, err)
+	}
+}
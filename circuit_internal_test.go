@@ -0,0 +1,50 @@
+package steamweb
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	const path = "/ISteamUser/GetPlayerBans/v1/"
+
+	SetCircuitBreaker(2, time.Millisecond*50)
+	defer SetCircuitBreaker(0, 0)
+
+	failure := &StatusError{StatusCode: http.StatusBadGateway}
+
+	circuit.record("", path, failure)
+	require.NoError(t, circuit.allow("", path))
+
+	circuit.record("", path, errors.New("not retryable"))
+	require.NoError(t, circuit.allow("", path))
+
+	circuit.record("", path, failure)
+	require.ErrorIs(t, circuit.allow("", path), ErrCircuitOpen)
+	require.Equal(t, []string{normalizeEndpointPath(path)}, circuit.open(""))
+	require.NoError(t, circuit.allow("other", path), "circuits must be separate for each key")
+	require.NoError(t, circuit.allow("", "/ISteamUser/GetPlayerSummaries/v0002/"))
+
+	time.Sleep(time.Millisecond * 60)
+
+	// Only a single probe is let through once the cooldown has passed.
+	require.NoError(t, circuit.allow("", path))
+	require.ErrorIs(t, circuit.allow("", path), ErrCircuitOpen)
+	require.Empty(t, circuit.open(""))
+
+	circuit.record("", path, failure)
+	require.ErrorIs(t, circuit.allow("", path), ErrCircuitOpen)
+
+	circuit.record("", path, nil)
+	require.NoError(t, circuit.allow("", path))
+	require.NoError(t, circuit.allow("", path))
+
+	SetCircuitBreaker(0, 0)
+	circuit.record("", path, failure)
+	circuit.record("", path, failure)
+	require.NoError(t, circuit.allow("", path))
+}
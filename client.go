@@ -0,0 +1,494 @@
+package steamweb
+
+import (
+	"context"
+	"net"
+	"net/url"
+	"time"
+
+	"github.com/leighmacdonald/steamid/v4/steamid"
+	"github.com/pkg/errors"
+)
+
+// Client holds its own api key, language, HTTPClientHandler, request timeout and cache, allowing multiple steam
+// keys to be used from the same process. Its methods mirror the package level functions, which use the package
+// level configuration set with SetKey, SetLang and SetDefaultClient along with a shared cache.
+//
+// Options set on the context passed to a method, such as WithLang, WithTimeout and WithHTTPClient, take precedence
+// over those of the client. Package level settings without a client equivalent, such as SetRetryPolicy and
+// SetCircuitBreaker, apply to every client, although circuit breaker state is tracked separately for each key.
+// A Client is safe for concurrent use.
+type Client struct {
+	apiKey     string
+	lang       string
+	httpClient HTTPClientHandler
+	timeout    time.Duration
+	cache      *memoryCache
+}
+
+// ClientOption configures a Client created with NewClient.
+type ClientOption func(c *Client) error
+
+// WithClientLang sets the language used for results which have translations available, see SetLang.
+func WithClientLang(lang string) ClientOption {
+	const validLangStringLen = 5
+
+	return func(c *Client) error {
+		if len(lang) != validLangStringLen {
+			return errors.New("Invalid ISO_639-1 language code")
+		}
+
+		c.lang = lang
+
+		return nil
+	}
+}
+
+// WithClientHTTPClient sets the HTTPClientHandler used for requests. When not set, the package level default
+// client set with SetDefaultClient is used.
+func WithClientHTTPClient(client HTTPClientHandler) ClientOption {
+	return func(c *Client) error {
+		c.httpClient = client
+
+		return nil
+	}
+}
+
+// WithClientTimeout sets the timeout applied to every request, see WithTimeout. When not set, the default and
+// per endpoint timeouts set with SetEndpointTimeout are used.
+func WithClientTimeout(timeout time.Duration) ClientOption {
+	return func(c *Client) error {
+		if timeout <= 0 {
+			return errors.New("Invalid timeout, must be greater than 0")
+		}
+
+		c.timeout = timeout
+
+		return nil
+	}
+}
+
+// NewClient returns a Client using the api key with its own empty cache. An empty key falls back to the package
+// level key set with SetKey or the STEAM_TOKEN environment variable.
+func NewClient(key string, opts ...ClientOption) (*Client, error) {
+	if len(key) != 32 && len(key) != 0 {
+		return nil, errors.New("Tried to set invalid key, must be 32 chars or 0 to remove it")
+	}
+
+	client := &Client{apiKey: key, cache: newMemoryCache()}
+
+	for _, opt := range opts {
+		if errOpt := opt(client); errOpt != nil {
+			return nil, errOpt
+		}
+	}
+
+	return client, nil
+}
+
+// context returns a copy of ctx carrying the clients configuration, without overriding any values already set on
+// the context by the caller.
+func (c *Client) context(ctx context.Context) context.Context {
+	ctx = withCache(withAPIKey(ctx, c.apiKey), c.cache)
+
+	if _, found := ctx.Value(langKey).(string); !found && c.lang != "" {
+		ctx = WithLang(ctx, c.lang)
+	}
+
+	if _, found := timeoutFrom(ctx); !found && c.timeout > 0 {
+		ctx = WithTimeout(ctx, c.timeout)
+	}
+
+	return ctx
+}
+
+// CacheEntries returns the state of every populated entry in the clients cache, see CacheEntries.
+func (c *Client) CacheEntries() []CacheEntryInfo {
+	return c.cache.entries()
+}
+
+// ClearCache removes all results cached by the client.
+func (c *Client) ClearCache() {
+	c.cache.clear()
+}
+
+// AppListAge returns how long ago the app list cached by the client was fetched, see AppListAge.
+func (c *Client) AppListAge() (time.Duration, bool) {
+	return c.cache.age(cacheKeyAppList)
+}
+
+// OpenCircuits returns the endpoint paths that currently have an open circuit for the clients key, sorted. See
+// SetCircuitBreaker.
+func (c *Client) OpenCircuits() []string {
+	return circuit.open(keyFrom(withAPIKey(context.Background(), c.apiKey)))
+}
+
+// AppByID is the Client equivalent of AppByID.
+func (c *Client) AppByID(ctx context.Context, appID steamid.AppID) (App, bool, error) {
+	return AppByID(c.context(ctx), c.httpClient, appID)
+}
+
+// FindApps is the Client equivalent of FindApps.
+func (c *Client) FindApps(ctx context.Context, prefix string) ([]App, error) {
+	return FindApps(c.context(ctx), c.httpClient, prefix)
+}
+
+// StartAppListRefresh is the Client equivalent of StartAppListRefresh.
+func (c *Client) StartAppListRefresh(ctx context.Context, interval time.Duration) <-chan struct{} {
+	return StartAppListRefresh(c.context(ctx), c.httpClient, interval)
+}
+
+// PlayerBanReport is the Client equivalent of PlayerBanReport.
+func (c *Client) PlayerBanReport(ctx context.Context, steamIDs steamid.Collection) ([]BanReport, error) {
+	return PlayerBanReport(c.context(ctx), c.httpClient, steamIDs)
+}
+
+// ResolveVanityURLs is the Client equivalent of ResolveVanityURLs.
+func (c *Client) ResolveVanityURLs(ctx context.Context, queries []string) (map[string]steamid.SteamID, map[string]error) {
+	return ResolveVanityURLs(c.context(ctx), c.httpClient, queries)
+}
+
+// GetPlayerBansMap is the Client equivalent of GetPlayerBansMap.
+func (c *Client) GetPlayerBansMap(ctx context.Context, steamIDs steamid.Collection) (map[steamid.SteamID]PlayerBanState, error) {
+	return GetPlayerBansMap(c.context(ctx), c.httpClient, steamIDs)
+}
+
+// PlayerSummariesAll is the Client equivalent of PlayerSummariesAll.
+func (c *Client) PlayerSummariesAll(ctx context.Context, steamIDs steamid.Collection, opts *PlayerSummariesOptions) ([]PlayerSummary, error) {
+	return PlayerSummariesAll(c.context(ctx), c.httpClient, steamIDs, opts)
+}
+
+// GetNewsForApps is the Client equivalent of GetNewsForApps.
+func (c *Client) GetNewsForApps(ctx context.Context, appIDs []steamid.AppID, opts *GetNewsForAppOptions) (map[steamid.AppID][]NewsItem, error) {
+	return GetNewsForApps(c.context(ctx), c.httpClient, appIDs, opts)
+}
+
+// GetOwnedGamesMulti is the Client equivalent of GetOwnedGamesMulti.
+func (c *Client) GetOwnedGamesMulti(ctx context.Context, steamIDs steamid.Collection) (map[steamid.SteamID][]OwnedGame, map[steamid.SteamID]error) {
+	return GetOwnedGamesMulti(c.context(ctx), c.httpClient, steamIDs)
+}
+
+// GetRecentlyPlayedGamesMulti is the Client equivalent of GetRecentlyPlayedGamesMulti.
+func (c *Client) GetRecentlyPlayedGamesMulti(ctx context.Context, steamIDs steamid.Collection) (map[steamid.SteamID][]RecentGame, map[steamid.SteamID]error) {
+	return GetRecentlyPlayedGamesMulti(c.context(ctx), c.httpClient, steamIDs)
+}
+
+// GetCSGOStats is the Client equivalent of GetCSGOStats.
+func (c *Client) GetCSGOStats(ctx context.Context, steamID steamid.SteamID) (*CSGOStats, error) {
+	return GetCSGOStats(c.context(ctx), c.httpClient, steamID)
+}
+
+// GetDotaMatchHistory is the Client equivalent of GetDotaMatchHistory.
+func (c *Client) GetDotaMatchHistory(ctx context.Context, opts DotaMatchHistoryOptions) (*DotaMatchHistory, error) {
+	return GetDotaMatchHistory(c.context(ctx), c.httpClient, opts)
+}
+
+// GetDotaMatchDetails is the Client equivalent of GetDotaMatchDetails.
+func (c *Client) GetDotaMatchDetails(ctx context.Context, matchID uint64) (*DotaMatchDetails, error) {
+	return GetDotaMatchDetails(c.context(ctx), c.httpClient, matchID)
+}
+
+// KeyCapabilities is the Client equivalent of KeyCapabilities.
+func (c *Client) KeyCapabilities(ctx context.Context) (*KeyInfo, error) {
+	return KeyCapabilities(c.context(ctx), c.httpClient)
+}
+
+// GetMarketPriceHistory is the Client equivalent of GetMarketPriceHistory.
+func (c *Client) GetMarketPriceHistory(ctx context.Context, appID steamid.AppID, marketHashName string) ([]PricePoint, error) {
+	return GetMarketPriceHistory(c.context(ctx), c.httpClient, appID, marketHashName)
+}
+
+// GetPlayerProfile is the Client equivalent of GetPlayerProfile.
+func (c *Client) GetPlayerProfile(ctx context.Context, steamID steamid.SteamID, opts ProfileOptions) (*PlayerProfile, error) {
+	return GetPlayerProfile(c.context(ctx), c.httpClient, steamID, opts)
+}
+
+// StartPlayerCountSampler is the Client equivalent of StartPlayerCountSampler.
+func (c *Client) StartPlayerCountSampler(ctx context.Context, appID steamid.AppID, interval time.Duration) <-chan PlayerCountSample {
+	return StartPlayerCountSampler(c.context(ctx), c.httpClient, appID, interval)
+}
+
+// RecordPlayerCounts is the Client equivalent of RecordPlayerCounts.
+func (c *Client) RecordPlayerCounts(ctx context.Context, appID steamid.AppID, interval time.Duration, store PlayerCountStore) <-chan struct{} {
+	return RecordPlayerCounts(c.context(ctx), c.httpClient, appID, interval, store)
+}
+
+// GetServerListByRegion is the Client equivalent of GetServerListByRegion.
+func (c *Client) GetServerListByRegion(ctx context.Context, filters map[string]string, regions ...ServerRegion) ([]Server, error) {
+	return GetServerListByRegion(c.context(ctx), c.httpClient, filters, regions...)
+}
+
+// GetServersAtAddressDetailed is the Client equivalent of GetServersAtAddressDetailed.
+func (c *Client) GetServersAtAddressDetailed(ctx context.Context, ipAddr net.IP) ([]DetailedServer, error) {
+	return GetServersAtAddressDetailed(c.context(ctx), c.httpClient, ipAddr)
+}
+
+// WatchServerList is the Client equivalent of WatchServerList.
+func (c *Client) WatchServerList(ctx context.Context, filters map[string]string, interval time.Duration) (<-chan ServerListUpdate, error) {
+	return WatchServerList(c.context(ctx), c.httpClient, filters, interval)
+}
+
+// GetAppDetails is the Client equivalent of GetAppDetails.
+func (c *Client) GetAppDetails(ctx context.Context, appID steamid.AppID) (*AppDetails, error) {
+	return GetAppDetails(c.context(ctx), c.httpClient, appID)
+}
+
+// GetAppDLC is the Client equivalent of GetAppDLC.
+func (c *Client) GetAppDLC(ctx context.Context, appID steamid.AppID) ([]App, error) {
+	return GetAppDLC(c.context(ctx), c.httpClient, appID)
+}
+
+// GetFeatured is the Client equivalent of GetFeatured.
+func (c *Client) GetFeatured(ctx context.Context, opts *FeaturedOptions) (*FeaturedApps, error) {
+	return GetFeatured(c.context(ctx), c.httpClient, opts)
+}
+
+// GetTradeOffer is the Client equivalent of GetTradeOffer.
+func (c *Client) GetTradeOffer(ctx context.Context, tradeOfferID uint64, getDescriptions bool) (*TradeOffer, error) {
+	return GetTradeOffer(c.context(ctx), c.httpClient, tradeOfferID, getDescriptions)
+}
+
+// ValidateCallParams is the Client equivalent of ValidateCallParams.
+func (c *Client) ValidateCallParams(ctx context.Context, iface string, method string, params url.Values) error {
+	return ValidateCallParams(c.context(ctx), c.httpClient, iface, method, params)
+}
+
+// GetAppList is the Client equivalent of GetAppList.
+func (c *Client) GetAppList(ctx context.Context) ([]App, error) {
+	return GetAppList(c.context(ctx), c.httpClient)
+}
+
+// StreamAppList is the Client equivalent of StreamAppList.
+func (c *Client) StreamAppList(ctx context.Context, fn func(App) bool) error {
+	return StreamAppList(c.context(ctx), c.httpClient, fn)
+}
+
+// GetAppListByType is the Client equivalent of GetAppListByType.
+func (c *Client) GetAppListByType(ctx context.Context, types ...AppType) ([]StoreApp, error) {
+	return GetAppListByType(c.context(ctx), c.httpClient, types...)
+}
+
+// PlayerSummaries is the Client equivalent of PlayerSummaries.
+func (c *Client) PlayerSummaries(ctx context.Context, steamIDs steamid.Collection) ([]PlayerSummary, error) {
+	return PlayerSummaries(c.context(ctx), c.httpClient, steamIDs)
+}
+
+// GetPlayerLinkDetails is the Client equivalent of GetPlayerLinkDetails.
+func (c *Client) GetPlayerLinkDetails(ctx context.Context, steamIDs steamid.Collection) ([]PlayerLinkDetails, error) {
+	return GetPlayerLinkDetails(c.context(ctx), c.httpClient, steamIDs)
+}
+
+// GetPlayerBans is the Client equivalent of GetPlayerBans.
+func (c *Client) GetPlayerBans(ctx context.Context, steamIDs steamid.Collection) ([]PlayerBanState, error) {
+	return GetPlayerBans(c.context(ctx), c.httpClient, steamIDs)
+}
+
+// GetUserGroupList is the Client equivalent of GetUserGroupList.
+func (c *Client) GetUserGroupList(ctx context.Context, steamID steamid.SteamID) ([]steamid.SteamID, error) {
+	return GetUserGroupList(c.context(ctx), c.httpClient, steamID)
+}
+
+// GetFriendList is the Client equivalent of GetFriendList.
+func (c *Client) GetFriendList(ctx context.Context, steamID steamid.SteamID) ([]Friend, error) {
+	return GetFriendList(c.context(ctx), c.httpClient, steamID)
+}
+
+// GetServersAtAddress is the Client equivalent of GetServersAtAddress.
+func (c *Client) GetServersAtAddress(ctx context.Context, ipAddr net.IP) ([]ServerAtAddress, error) {
+	return GetServersAtAddress(c.context(ctx), c.httpClient, ipAddr)
+}
+
+// GetServerList is the Client equivalent of GetServerList.
+func (c *Client) GetServerList(ctx context.Context, filters map[string]string) ([]Server, error) {
+	return GetServerList(c.context(ctx), c.httpClient, filters)
+}
+
+// UpToDateCheck is the Client equivalent of UpToDateCheck.
+func (c *Client) UpToDateCheck(ctx context.Context, appID steamid.AppID, version uint32) (*VersionCheckInfo, error) {
+	return UpToDateCheck(c.context(ctx), c.httpClient, appID, version)
+}
+
+// GetNewsForApp is the Client equivalent of GetNewsForApp.
+func (c *Client) GetNewsForApp(ctx context.Context, appID steamid.AppID, opts *GetNewsForAppOptions) ([]NewsItem, error) {
+	return GetNewsForApp(c.context(ctx), c.httpClient, appID, opts)
+}
+
+// GetNumberOfCurrentPlayers is the Client equivalent of GetNumberOfCurrentPlayers.
+func (c *Client) GetNumberOfCurrentPlayers(ctx context.Context, appID steamid.AppID) (int, error) {
+	return GetNumberOfCurrentPlayers(c.context(ctx), c.httpClient, appID)
+}
+
+// GetUserStatsForGame is the Client equivalent of GetUserStatsForGame.
+func (c *Client) GetUserStatsForGame(ctx context.Context, steamID steamid.SteamID, appID steamid.AppID) (PlayerStats, error) {
+	return GetUserStatsForGame(c.context(ctx), c.httpClient, steamID, appID)
+}
+
+// GetGameStatsSchema is the Client equivalent of GetGameStatsSchema.
+func (c *Client) GetGameStatsSchema(ctx context.Context, appID steamid.AppID) (*GameStatsSchema, error) {
+	return GetGameStatsSchema(c.context(ctx), c.httpClient, appID)
+}
+
+// GetUserStatsDetailed is the Client equivalent of GetUserStatsDetailed.
+func (c *Client) GetUserStatsDetailed(ctx context.Context, steamID steamid.SteamID, appID steamid.AppID) (*PlayerStatsDetailed, error) {
+	return GetUserStatsDetailed(c.context(ctx), c.httpClient, steamID, appID)
+}
+
+// GetPlayerAchievements is the Client equivalent of GetPlayerAchievements.
+func (c *Client) GetPlayerAchievements(ctx context.Context, steamID steamid.SteamID, appID steamid.AppID) (*PlayerAchievements, error) {
+	return GetPlayerAchievements(c.context(ctx), c.httpClient, steamID, appID)
+}
+
+// GetGlobalAchievementPercentagesForApp is the Client equivalent of GetGlobalAchievementPercentagesForApp.
+func (c *Client) GetGlobalAchievementPercentagesForApp(ctx context.Context, appID steamid.AppID) ([]AchievementPercentage, error) {
+	return GetGlobalAchievementPercentagesForApp(c.context(ctx), c.httpClient, appID)
+}
+
+// GetPlayerAchievementCompletion is the Client equivalent of GetPlayerAchievementCompletion.
+func (c *Client) GetPlayerAchievementCompletion(ctx context.Context, steamID steamid.SteamID, appID steamid.AppID) (*AchievementCompletion, error) {
+	return GetPlayerAchievementCompletion(c.context(ctx), c.httpClient, steamID, appID)
+}
+
+// GetPlayerItems is the Client equivalent of GetPlayerItems.
+func (c *Client) GetPlayerItems(ctx context.Context, steamID steamid.SteamID, appID steamid.AppID) ([]InventoryItem, int, error) {
+	return GetPlayerItems(c.context(ctx), c.httpClient, steamID, appID)
+}
+
+// GetSchema is the Client equivalent of GetSchema.
+func (c *Client) GetSchema(ctx context.Context, appID steamid.AppID) (*Schema, error) {
+	return GetSchema(c.context(ctx), c.httpClient, appID)
+}
+
+// GetSchemaOverview is the Client equivalent of GetSchemaOverview.
+func (c *Client) GetSchemaOverview(ctx context.Context, appID steamid.AppID) (*SchemaOverview, error) {
+	return GetSchemaOverview(c.context(ctx), c.httpClient, appID)
+}
+
+// GetSchemaItems is the Client equivalent of GetSchemaItems.
+func (c *Client) GetSchemaItems(ctx context.Context, appID steamid.AppID) ([]SchemaItem, error) {
+	return GetSchemaItems(c.context(ctx), c.httpClient, appID)
+}
+
+// GetSchemaItemsWithOptions is the Client equivalent of GetSchemaItemsWithOptions.
+func (c *Client) GetSchemaItemsWithOptions(ctx context.Context, appID steamid.AppID, opts *GetSchemaItemsOptions) ([]SchemaItem, error) {
+	return GetSchemaItemsWithOptions(c.context(ctx), c.httpClient, appID, opts)
+}
+
+// GetSchemaURL is the Client equivalent of GetSchemaURL.
+func (c *Client) GetSchemaURL(ctx context.Context, appID steamid.AppID) (string, error) {
+	return GetSchemaURL(c.context(ctx), c.httpClient, appID)
+}
+
+// GetItemsGameText is the Client equivalent of GetItemsGameText.
+func (c *Client) GetItemsGameText(ctx context.Context, appID steamid.AppID) ([]byte, error) {
+	return GetItemsGameText(c.context(ctx), c.httpClient, appID)
+}
+
+// GetStoreMetaData is the Client equivalent of GetStoreMetaData.
+func (c *Client) GetStoreMetaData(ctx context.Context, appID steamid.AppID) (*StoreMetaData, error) {
+	return GetStoreMetaData(c.context(ctx), c.httpClient, appID)
+}
+
+// GetSupportedAPIList is the Client equivalent of GetSupportedAPIList.
+func (c *Client) GetSupportedAPIList(ctx context.Context) ([]SupportedAPIInterfaces, error) {
+	return GetSupportedAPIList(c.context(ctx), c.httpClient)
+}
+
+// GetSupportedAPIInterface is the Client equivalent of GetSupportedAPIInterface.
+func (c *Client) GetSupportedAPIInterface(ctx context.Context, name string) (*SupportedAPIInterfaces, error) {
+	return GetSupportedAPIInterface(c.context(ctx), c.httpClient, name)
+}
+
+// EndpointAvailable is the Client equivalent of EndpointAvailable.
+func (c *Client) EndpointAvailable(ctx context.Context, iface string, method string) (bool, int, error) {
+	return EndpointAvailable(c.context(ctx), c.httpClient, iface, method)
+}
+
+// ResolveVanityURL is the Client equivalent of ResolveVanityURL.
+func (c *Client) ResolveVanityURL(ctx context.Context, query string) (steamid.SteamID, error) {
+	return ResolveVanityURL(c.context(ctx), c.httpClient, query)
+}
+
+// ResolveAny is the Client equivalent of ResolveAny.
+func (c *Client) ResolveAny(ctx context.Context, input string) (steamid.SteamID, error) {
+	return ResolveAny(c.context(ctx), c.httpClient, input)
+}
+
+// GetSteamLevel is the Client equivalent of GetSteamLevel.
+func (c *Client) GetSteamLevel(ctx context.Context, sid steamid.SteamID) (int, error) {
+	return GetSteamLevel(c.context(ctx), c.httpClient, sid)
+}
+
+// GetRecentlyPlayedGames is the Client equivalent of GetRecentlyPlayedGames.
+func (c *Client) GetRecentlyPlayedGames(ctx context.Context, sid steamid.SteamID) ([]RecentGame, error) {
+	return GetRecentlyPlayedGames(c.context(ctx), c.httpClient, sid)
+}
+
+// GetOwnedGames is the Client equivalent of GetOwnedGames.
+func (c *Client) GetOwnedGames(ctx context.Context, sid steamid.SteamID) (OwnedGames, error) {
+	return GetOwnedGames(c.context(ctx), c.httpClient, sid)
+}
+
+// GetOwnedGamesWithOptions is the Client equivalent of GetOwnedGamesWithOptions.
+func (c *Client) GetOwnedGamesWithOptions(ctx context.Context, sid steamid.SteamID, opts *GetOwnedGamesOptions) (OwnedGames, error) {
+	return GetOwnedGamesWithOptions(c.context(ctx), c.httpClient, sid, opts)
+}
+
+// ResolveAppNames is the Client equivalent of ResolveAppNames.
+func (c *Client) ResolveAppNames(ctx context.Context, games []OwnedGame) error {
+	return ResolveAppNames(c.context(ctx), c.httpClient, games)
+}
+
+// GetSingleGamePlaytime is the Client equivalent of GetSingleGamePlaytime.
+func (c *Client) GetSingleGamePlaytime(ctx context.Context, sid steamid.SteamID, appID steamid.AppID) (*GamePlaytime, error) {
+	return GetSingleGamePlaytime(c.context(ctx), c.httpClient, sid, appID)
+}
+
+// GetProfileItemsEquipped is the Client equivalent of GetProfileItemsEquipped.
+func (c *Client) GetProfileItemsEquipped(ctx context.Context, sid steamid.SteamID) (*ProfileItemsEquipped, error) {
+	return GetProfileItemsEquipped(c.context(ctx), c.httpClient, sid)
+}
+
+// GetWishlist is the Client equivalent of GetWishlist.
+func (c *Client) GetWishlist(ctx context.Context, sid steamid.SteamID) ([]WishlistItem, error) {
+	return GetWishlist(c.context(ctx), c.httpClient, sid)
+}
+
+// GetBadges is the Client equivalent of GetBadges.
+func (c *Client) GetBadges(ctx context.Context, sid steamid.SteamID) (*BadgeStatus, error) {
+	return GetBadges(c.context(ctx), c.httpClient, sid)
+}
+
+// GetCommunityBadgeProgress is the Client equivalent of GetCommunityBadgeProgress.
+func (c *Client) GetCommunityBadgeProgress(ctx context.Context, sid steamid.SteamID) ([]BadgeQuestStatus, error) {
+	return GetCommunityBadgeProgress(c.context(ctx), c.httpClient, sid)
+}
+
+// GetAssetClassInfo is the Client equivalent of GetAssetClassInfo.
+func (c *Client) GetAssetClassInfo(ctx context.Context, appID steamid.AppID, classIDs []int) ([]Asset, error) {
+	return GetAssetClassInfo(c.context(ctx), c.httpClient, appID, classIDs)
+}
+
+// GetAssetClassInfoMap is the Client equivalent of GetAssetClassInfoMap.
+func (c *Client) GetAssetClassInfoMap(ctx context.Context, appID steamid.AppID, classIDs []int) (map[int]Asset, []int, error) {
+	return GetAssetClassInfoMap(c.context(ctx), c.httpClient, appID, classIDs)
+}
+
+// GetGroupMembers is the Client equivalent of GetGroupMembers.
+func (c *Client) GetGroupMembers(ctx context.Context, groupID steamid.SteamID) (steamid.Collection, error) {
+	return GetGroupMembers(c.context(ctx), c.httpClient, groupID)
+}
+
+// QueryFiles is the Client equivalent of QueryFiles.
+func (c *Client) QueryFiles(ctx context.Context, opts QueryFilesOptions) (*QueryFilesResult, error) {
+	return QueryFiles(c.context(ctx), c.httpClient, opts)
+}
+
+// QueryFilesAll is the Client equivalent of QueryFilesAll.
+func (c *Client) QueryFilesAll(ctx context.Context, opts QueryFilesOptions, fn func(files []PublishedFileDetails) error) error {
+	return QueryFilesAll(c.context(ctx), c.httpClient, opts, fn)
+}
+
+// GetPublishedFileDetails is the Client equivalent of GetPublishedFileDetails.
+func (c *Client) GetPublishedFileDetails(ctx context.Context, fileIDs []uint64) (map[uint64]PublishedFileDetails, map[uint64]error) {
+	return GetPublishedFileDetails(c.context(ctx), c.httpClient, fileIDs)
+}
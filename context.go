@@ -0,0 +1,182 @@
+package steamweb
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"time"
+)
+
+type contextKey int
+
+const (
+	langKey contextKey = iota
+	clientKey
+	extraParamsKey
+	timeoutKey
+	cacheBypassKey
+	cacheTTLKey
+	validateAppIDKey
+	apiKeyKey
+	cacheCtxKey
+)
+
+// WithLang returns a copy of ctx that overrides the package level language set with SetLang for any
+// request made using it. This allows fetching results in multiple languages concurrently.
+func WithLang(ctx context.Context, newLang string) context.Context {
+	return context.WithValue(ctx, langKey, strings.ToLower(newLang))
+}
+
+// langFrom returns the language set on the context with WithLang, falling back to the package level language.
+func langFrom(ctx context.Context) string {
+	if ctxLang, ok := ctx.Value(langKey).(string); ok && ctxLang != "" {
+		return ctxLang
+	}
+
+	return Lang()
+}
+
+// WithHTTPClient returns a copy of ctx that routes any request made using it through client, taking precedence over
+// both the client passed to the function and the package default client. A nil client returns ctx unchanged.
+func WithHTTPClient(ctx context.Context, client HTTPClientHandler) context.Context {
+	if client == nil {
+		return ctx
+	}
+
+	return context.WithValue(ctx, clientKey, client)
+}
+
+// clientFrom returns the client set on the context with WithHTTPClient, or nil when unset.
+func clientFrom(ctx context.Context) HTTPClientHandler {
+	if client, ok := ctx.Value(clientKey).(HTTPClientHandler); ok {
+		return client
+	}
+
+	return nil
+}
+
+// WithExtraParams returns a copy of ctx that adds params to the query string of any api request made using it.
+// This allows passing parameters that steam supports but the package does not model yet. Parameters already set
+// by the function being called, as well as key and format, always take precedence and conflicting extra values
+// are ignored.
+func WithExtraParams(ctx context.Context, params url.Values) context.Context {
+	extra := url.Values{}
+
+	for key, values := range extraParamsFrom(ctx) {
+		extra[key] = values
+	}
+
+	for key, values := range params {
+		extra[key] = append([]string(nil), values...)
+	}
+
+	return context.WithValue(ctx, extraParamsKey, extra)
+}
+
+// extraParamsFrom returns the params set on the context with WithExtraParams.
+func extraParamsFrom(ctx context.Context) url.Values {
+	if extra, ok := ctx.Value(extraParamsKey).(url.Values); ok {
+		return extra
+	}
+
+	return nil
+}
+
+// mergeExtraParams adds the params set on the context with WithExtraParams to values, skipping any keys
+// that are already set.
+func mergeExtraParams(ctx context.Context, values url.Values) {
+	for key, extra := range extraParamsFrom(ctx) {
+		if _, found := values[key]; !found {
+			values[key] = extra
+		}
+	}
+}
+
+// WithTimeout returns a copy of ctx that sets the timeout used for each individual request made using it,
+// overriding both the default timeout and any set with SetEndpointTimeout. Unlike context.WithTimeout, the
+// timeout applies separately to every request, including each page of paged endpoints and each retry.
+//
+// When no timeout is set with WithTimeout, a deadline already present on ctx is respected as is rather than being
+// shortened by the default request timeout.
+func WithTimeout(ctx context.Context, timeout time.Duration) context.Context {
+	return context.WithValue(ctx, timeoutKey, timeout)
+}
+
+// timeoutFrom returns the per request timeout set on the context with WithTimeout.
+func timeoutFrom(ctx context.Context) (time.Duration, bool) {
+	timeout, ok := ctx.Value(timeoutKey).(time.Duration)
+
+	return timeout, ok && timeout > 0
+}
+
+// WithCacheBypass returns a copy of ctx that skips reading cached results for any request made using it. Fresh
+// results are still written to the cache so later requests benefit from them.
+func WithCacheBypass(ctx context.Context) context.Context {
+	return context.WithValue(ctx, cacheBypassKey, true)
+}
+
+// cacheBypassed reports whether the context was created with WithCacheBypass.
+func cacheBypassed(ctx context.Context) bool {
+	bypass, ok := ctx.Value(cacheBypassKey).(bool)
+
+	return ok && bypass
+}
+
+// WithCacheTTL returns a copy of ctx that sets how long results fetched using it are cached, overriding the
+// default ttl of the endpoint. It only affects results written to the cache, not how long existing entries are
+// considered valid. A ttl of 0 or less is ignored.
+func WithCacheTTL(ctx context.Context, ttl time.Duration) context.Context {
+	return context.WithValue(ctx, cacheTTLKey, ttl)
+}
+
+// cacheTTL returns the ttl set on the context with WithCacheTTL, falling back to the endpoint default.
+func cacheTTL(ctx context.Context, fallback time.Duration) time.Duration {
+	if ttl, ok := ctx.Value(cacheTTLKey).(time.Duration); ok && ttl > 0 {
+		return ttl
+	}
+
+	return fallback
+}
+
+// WithValidateAppID returns a copy of ctx that makes functions supporting it, such as GetNumberOfCurrentPlayers,
+// check that the app id exists in the app list before making the request. Unknown app ids fail with
+// ErrUnknownAppID instead of a less obvious error from steam. The app list is fetched and cached if needed, so
+// this is intended for interactive tools rather than hot paths.
+func WithValidateAppID(ctx context.Context) context.Context {
+	return context.WithValue(ctx, validateAppIDKey, true)
+}
+
+// validateAppIDEnabled reports whether the context was created with WithValidateAppID.
+func validateAppIDEnabled(ctx context.Context) bool {
+	validate, ok := ctx.Value(validateAppIDKey).(bool)
+
+	return ok && validate
+}
+
+// withAPIKey returns a copy of ctx that uses key for requests instead of the package level key set with SetKey.
+func withAPIKey(ctx context.Context, key string) context.Context {
+	return context.WithValue(ctx, apiKeyKey, key)
+}
+
+// keyFrom returns the key set on the context with withAPIKey, falling back to the package level key.
+func keyFrom(ctx context.Context) string {
+	if key, ok := ctx.Value(apiKeyKey).(string); ok && key != "" {
+		return key
+	}
+
+	return Key()
+}
+
+// withCache returns a copy of ctx that reads and writes cached results using c instead of the package level cache.
+func withCache(ctx context.Context, c *memoryCache) context.Context {
+	return context.WithValue(ctx, cacheCtxKey, c)
+}
+
+// cacheFrom returns the cache set on the context with withCache, falling back to the package level cache.
+func cacheFrom(ctx context.Context) *memoryCache {
+	if c, ok := ctx.Value(cacheCtxKey).(*memoryCache); ok && c != nil {
+		return c
+	}
+
+	return cache
+}
@@ -0,0 +1,99 @@
+package steamweb
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequestContextBaseCancel(t *testing.T) {
+	base, cancelBase := context.WithCancel(context.Background())
+
+	SetBaseContext(base)
+	defer SetBaseContext(nil)
+
+	reqCtx, cancel := requestContext(context.Background(), time.Minute)
+	defer cancel()
+
+	require.NoError(t, reqCtx.Err())
+
+	cancelBase()
+
+	select {
+	case <-reqCtx.Done():
+	case <-time.After(time.Second):
+		t.Fatal("request context not cancelled by base context")
+	}
+
+	require.ErrorIs(t, reqCtx.Err(), context.Canceled)
+
+	newCtx, newCancel := requestContext(context.Background(), time.Minute)
+	defer newCancel()
+
+	require.ErrorIs(t, newCtx.Err(), context.Canceled)
+}
+
+func TestResolveClient(t *testing.T) {
+	passed := &http.Client{}
+	override := &http.Client{}
+
+	require.Same(t, passed, resolveClient(context.Background(), passed))
+	require.Same(t, override, resolveClient(WithHTTPClient(context.Background(), override), passed))
+	require.Same(t, passed, resolveClient(WithHTTPClient(context.Background(), nil), passed))
+	require.NotNil(t, resolveClient(context.Background(), nil))
+}
+
+func TestMergeExtraParams(t *testing.T) {
+	ctx := WithExtraParams(context.Background(), url.Values{"include_free_sub": {"true"}, "appid": {"730"}})
+	ctx = WithExtraParams(ctx, url.Values{"l": {"german"}})
+
+	values := url.Values{"appid": {"440"}}
+	mergeExtraParams(ctx, values)
+
+	require.Equal(t, "440", values.Get("appid"))
+	require.Equal(t, "true", values.Get("include_free_sub"))
+	require.Equal(t, "german", values.Get("l"))
+
+	empty := url.Values{}
+	mergeExtraParams(context.Background(), empty)
+	require.Empty(t, empty)
+}
+
+func TestRequestContextTimeout(t *testing.T) {
+	reqCtx, cancel := requestContext(context.Background(), time.Second)
+	defer cancel()
+
+	deadline, found := reqCtx.Deadline()
+	require.True(t, found)
+	require.WithinDuration(t, time.Now().Add(time.Second), deadline, time.Millisecond*100)
+
+	callerCtx, callerCancel := context.WithTimeout(context.Background(), time.Minute)
+	defer callerCancel()
+
+	callerReqCtx, cancelCaller := requestContext(callerCtx, time.Second)
+	defer cancelCaller()
+
+	callerDeadline, _ := callerReqCtx.Deadline()
+	require.WithinDuration(t, time.Now().Add(time.Minute), callerDeadline, time.Millisecond*100)
+
+	overrideCtx, cancelOverride := requestContext(WithTimeout(callerCtx, time.Second*5), time.Second)
+	defer cancelOverride()
+
+	overrideDeadline, _ := overrideCtx.Deadline()
+	require.WithinDuration(t, time.Now().Add(time.Second*5), overrideDeadline, time.Millisecond*100)
+}
+
+func TestLangPrecedence(t *testing.T) {
+	require.NoError(t, SetLang("de_DE"))
+	defer func() {
+		require.NoError(t, SetLang("en_US"))
+	}()
+
+	require.Equal(t, "de_de", Lang())
+	require.Equal(t, "de_de", langFrom(context.Background()))
+	require.Equal(t, "fr_fr", langFrom(WithLang(context.Background(), "fr_FR")))
+}
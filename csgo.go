@@ -0,0 +1,91 @@
+package steamweb
+
+import (
+	"context"
+
+	"github.com/leighmacdonald/steamid/v4/steamid"
+)
+
+// AppIDCSGO is the app id of Counter-Strike 2, formerly Counter-Strike: Global Offensive.
+const AppIDCSGO = steamid.AppID(730)
+
+// CSGOStats is a typed view of the lifetime stats returned by GetUserStatsForGame for Counter-Strike 2.
+type CSGOStats struct {
+	SteamID       steamid.SteamID `json:"steam_id"`
+	Kills         int             `json:"kills"`
+	Deaths        int             `json:"deaths"`
+	HeadshotKills int             `json:"headshot_kills"`
+	TimePlayed    int             `json:"time_played"`
+	Damage        int             `json:"damage"`
+	MoneyEarned   int             `json:"money_earned"`
+	BombsPlanted  int             `json:"bombs_planted"`
+	BombsDefused  int             `json:"bombs_defused"`
+	ShotsFired    int             `json:"shots_fired"`
+	ShotsHit      int             `json:"shots_hit"`
+	MVPs          int             `json:"mvps"`
+	RoundsWon     int             `json:"rounds_won"`
+	RoundsPlayed  int             `json:"rounds_played"`
+	MatchesWon    int             `json:"matches_won"`
+	MatchesPlayed int             `json:"matches_played"`
+	// KD is kills divided by deaths. When there are no deaths, it is equal to the number of kills.
+	KD float64 `json:"kd"`
+	// WinRate is the percentage of matches won, 0-100.
+	WinRate float64 `json:"win_rate"`
+	// Accuracy is the percentage of shots that hit, 0-100.
+	Accuracy float64 `json:"accuracy"`
+	// Raw contains every stat returned by steam, including those not mapped to a field.
+	Raw map[string]int `json:"raw"`
+}
+
+// GetCSGOStats fetches the Counter-Strike 2 stats of a user using GetUserStatsForGame and maps the well known stats
+// into named fields. ErrProfilePrivate is returned when the users stats are not public.
+func GetCSGOStats(ctx context.Context, client HTTPClientHandler, steamID steamid.SteamID) (*CSGOStats, error) {
+	stats, errStats := GetUserStatsForGame(ctx, client, steamID, AppIDCSGO)
+	if errStats != nil {
+		return nil, errStats
+	}
+
+	return newCSGOStats(stats), nil
+}
+
+func newCSGOStats(stats PlayerStats) *CSGOStats {
+	raw := make(map[string]int, len(stats.Stats))
+	for _, stat := range stats.Stats {
+		raw[stat.Name] = stat.Value
+	}
+
+	csgo := &CSGOStats{
+		SteamID:       stats.SteamID,
+		Kills:         raw["total_kills"],
+		Deaths:        raw["total_deaths"],
+		HeadshotKills: raw["total_kills_headshot"],
+		TimePlayed:    raw["total_time_played"],
+		Damage:        raw["total_damage_done"],
+		MoneyEarned:   raw["total_money_earned"],
+		BombsPlanted:  raw["total_planted_bombs"],
+		BombsDefused:  raw["total_defused_bombs"],
+		ShotsFired:    raw["total_shots_fired"],
+		ShotsHit:      raw["total_shots_hit"],
+		MVPs:          raw["total_mvps"],
+		RoundsWon:     raw["total_wins"],
+		RoundsPlayed:  raw["total_rounds_played"],
+		MatchesWon:    raw["total_matches_won"],
+		MatchesPlayed: raw["total_matches_played"],
+		Raw:           raw,
+	}
+
+	csgo.KD = float64(csgo.Kills)
+	if csgo.Deaths > 0 {
+		csgo.KD = float64(csgo.Kills) / float64(csgo.Deaths)
+	}
+
+	if csgo.MatchesPlayed > 0 {
+		csgo.WinRate = float64(csgo.MatchesWon) / float64(csgo.MatchesPlayed) * 100
+	}
+
+	if csgo.ShotsFired > 0 {
+		csgo.Accuracy = float64(csgo.ShotsHit) / float64(csgo.ShotsFired) * 100
+	}
+
+	return csgo
+}
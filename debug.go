@@ -0,0 +1,55 @@
+package steamweb
+
+import (
+	"fmt"
+	"io"
+	"net/url"
+	"sync"
+)
+
+var (
+	debugOut io.Writer  //nolint:gochecknoglobals
+	debugMu  sync.Mutex //nolint:gochecknoglobals
+)
+
+// SetDebug enables dumping the full url and raw response body of every api request to the writer. The api key
+// is redacted from the logged urls so the output is safe to share in bug reports. Passing nil disables it, which
+// is the default.
+func SetDebug(w io.Writer) {
+	debugMu.Lock()
+	debugOut = w
+	debugMu.Unlock()
+}
+
+// debugEnabled reports if a debug writer is currently set.
+func debugEnabled() bool {
+	debugMu.Lock()
+	defer debugMu.Unlock()
+
+	return debugOut != nil
+}
+
+// debugf writes the formatted message to the debug writer, if set.
+func debugf(format string, args ...any) {
+	debugMu.Lock()
+	defer debugMu.Unlock()
+
+	if debugOut == nil {
+		return
+	}
+
+	_, _ = fmt.Fprintf(debugOut, format, args...)
+}
+
+// redactURL returns the url as a string with the key query parameter value replaced.
+func redactURL(reqURL *url.URL) string {
+	redacted := *reqURL
+	query := redacted.Query()
+
+	if query.Has("key") {
+		query.Set("key", "REDACTED")
+		redacted.RawQuery = query.Encode()
+	}
+
+	return redacted.String()
+}
@@ -0,0 +1,19 @@
+package steamweb
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRedactURL(t *testing.T) {
+	reqURL, err := url.Parse("https://api.steampowered.com/ISteamUser/GetPlayerBans/v1/?key=0123456789ABCDEF&steamids=1")
+	require.NoError(t, err)
+
+	redacted := redactURL(reqURL)
+	require.NotContains(t, redacted, "0123456789ABCDEF")
+	require.Contains(t, redacted, "key=REDACTED")
+	require.Contains(t, redacted, "steamids=1")
+	require.Contains(t, reqURL.String(), "0123456789ABCDEF")
+}
@@ -0,0 +1,228 @@
+package steamweb
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+
+	"github.com/leighmacdonald/steamid/v4/steamid"
+)
+
+// AppIDDota2 is the app id of Dota 2.
+const AppIDDota2 = steamid.AppID(570)
+
+// dotaStatusOK is the status value returned by IDOTA2Match_570 on success.
+const dotaStatusOK = 1
+
+// DotaMatchHistoryOptions filters the results of GetDotaMatchHistory. Zero values are not sent.
+type DotaMatchHistoryOptions struct {
+	// AccountID limits results to matches played by the user. The user must have exposed their match data.
+	AccountID steamid.SteamID
+	HeroID    int
+	GameMode  int
+	// MatchesRequested is the number of matches to return, steam defaults to 100.
+	MatchesRequested int
+	// StartAtMatchID returns matches with an id equal to or older than the one provided, used for paging.
+	StartAtMatchID uint64
+}
+
+// DotaMatchPlayer is a player entry of a match returned by GetDotaMatchHistory.
+type DotaMatchPlayer struct {
+	// AccountID is the 32bit account id of the player, 4294967295 when the player is anonymous.
+	AccountID  uint32 `json:"account_id"`
+	PlayerSlot int    `json:"player_slot"`
+	TeamNumber int    `json:"team_number"`
+	TeamSlot   int    `json:"team_slot"`
+	HeroID     int    `json:"hero_id"`
+}
+
+// DotaMatch is a match summary returned by GetDotaMatchHistory.
+type DotaMatch struct {
+	MatchID       uint64            `json:"match_id"`
+	MatchSeqNum   uint64            `json:"match_seq_num"`
+	StartTime     int64             `json:"start_time"`
+	LobbyType     int               `json:"lobby_type"`
+	RadiantTeamID int               `json:"radiant_team_id"`
+	DireTeamID    int               `json:"dire_team_id"`
+	Players       []DotaMatchPlayer `json:"players"`
+}
+
+// DotaMatchHistory is a page of matches returned by GetDotaMatchHistory.
+type DotaMatchHistory struct {
+	NumResults       int         `json:"num_results"`
+	TotalResults     int         `json:"total_results"`
+	ResultsRemaining int         `json:"results_remaining"`
+	Matches          []DotaMatch `json:"matches"`
+}
+
+// GetDotaMatchHistory returns a page of recent Dota 2 matches. A *DotaError is returned when steam reports
+// a non success status, such as when the requested account has not exposed their match data.
+func GetDotaMatchHistory(ctx context.Context, client HTTPClientHandler, opts DotaMatchHistoryOptions) (*DotaMatchHistory, error) {
+	type response struct {
+		Result struct {
+			Status       int    `json:"status"`
+			StatusDetail string `json:"statusDetail"`
+			DotaMatchHistory
+		} `json:"result"`
+	}
+
+	values := url.Values{}
+
+	if opts.AccountID.Valid() {
+		values.Set("account_id", strconv.FormatUint(uint64(opts.AccountID.AccountID), 10))
+	}
+
+	if opts.HeroID > 0 {
+		values.Set("hero_id", strconv.Itoa(opts.HeroID))
+	}
+
+	if opts.GameMode > 0 {
+		values.Set("game_mode", strconv.Itoa(opts.GameMode))
+	}
+
+	if opts.MatchesRequested > 0 {
+		values.Set("matches_requested", strconv.Itoa(opts.MatchesRequested))
+	}
+
+	if opts.StartAtMatchID > 0 {
+		values.Set("start_at_match_id", strconv.FormatUint(opts.StartAtMatchID, 10))
+	}
+
+	var resp response
+
+	if errResp := apiRequest(ctx, client, "/IDOTA2Match_570/GetMatchHistory/v1", values, &resp); errResp != nil {
+		return nil, errResp
+	}
+
+	if resp.Result.Status != dotaStatusOK {
+		return nil, &DotaError{Status: resp.Result.Status, Detail: resp.Result.StatusDetail}
+	}
+
+	history := resp.Result.DotaMatchHistory
+
+	return &history, nil
+}
+
+// DotaAbilityUpgrade is a single ability level up of a player.
+type DotaAbilityUpgrade struct {
+	Ability int `json:"ability"`
+	// Time is the number of seconds since the start of the match.
+	Time  int `json:"time"`
+	Level int `json:"level"`
+}
+
+// DotaMatchDetailsPlayer is a player entry of a match returned by GetDotaMatchDetails.
+type DotaMatchDetailsPlayer struct {
+	// AccountID is the 32bit account id of the player, 4294967295 when the player is anonymous.
+	AccountID       uint32               `json:"account_id"`
+	PlayerSlot      int                  `json:"player_slot"`
+	TeamNumber      int                  `json:"team_number"`
+	TeamSlot        int                  `json:"team_slot"`
+	HeroID          int                  `json:"hero_id"`
+	Item0           int                  `json:"item_0"`
+	Item1           int                  `json:"item_1"`
+	Item2           int                  `json:"item_2"`
+	Item3           int                  `json:"item_3"`
+	Item4           int                  `json:"item_4"`
+	Item5           int                  `json:"item_5"`
+	Backpack0       int                  `json:"backpack_0"`
+	Backpack1       int                  `json:"backpack_1"`
+	Backpack2       int                  `json:"backpack_2"`
+	ItemNeutral     int                  `json:"item_neutral"`
+	Kills           int                  `json:"kills"`
+	Deaths          int                  `json:"deaths"`
+	Assists         int                  `json:"assists"`
+	LeaverStatus    int                  `json:"leaver_status"`
+	LastHits        int                  `json:"last_hits"`
+	Denies          int                  `json:"denies"`
+	GoldPerMin      int                  `json:"gold_per_min"`
+	XPPerMin        int                  `json:"xp_per_min"`
+	Level           int                  `json:"level"`
+	NetWorth        int                  `json:"net_worth"`
+	HeroDamage      int                  `json:"hero_damage"`
+	TowerDamage     int                  `json:"tower_damage"`
+	HeroHealing     int                  `json:"hero_healing"`
+	Gold            int                  `json:"gold"`
+	GoldSpent       int                  `json:"gold_spent"`
+	AbilityUpgrades []DotaAbilityUpgrade `json:"ability_upgrades"`
+}
+
+// Items returns the ids of the six main inventory slots, 0 for empty slots.
+func (p DotaMatchDetailsPlayer) Items() [6]int {
+	return [6]int{p.Item0, p.Item1, p.Item2, p.Item3, p.Item4, p.Item5}
+}
+
+// KDA returns (kills + assists) / deaths, treating zero deaths as one.
+func (p DotaMatchDetailsPlayer) KDA() float64 {
+	return float64(p.Kills+p.Assists) / float64(max(p.Deaths, 1))
+}
+
+// IsRadiant returns true when the player was on the radiant team.
+func (p DotaMatchDetailsPlayer) IsRadiant() bool {
+	return p.PlayerSlot < 128 //nolint:mnd
+}
+
+// DotaPickBan is a hero pick or ban in a captains mode match.
+type DotaPickBan struct {
+	IsPick bool `json:"is_pick"`
+	HeroID int  `json:"hero_id"`
+	// Team is 0 for radiant and 1 for dire.
+	Team  int `json:"team"`
+	Order int `json:"order"`
+}
+
+// DotaMatchDetails is the full result of a match returned by GetDotaMatchDetails.
+type DotaMatchDetails struct {
+	MatchID               uint64                   `json:"match_id"`
+	MatchSeqNum           uint64                   `json:"match_seq_num"`
+	RadiantWin            bool                     `json:"radiant_win"`
+	Duration              int                      `json:"duration"`
+	PreGameDuration       int                      `json:"pre_game_duration"`
+	StartTime             int64                    `json:"start_time"`
+	TowerStatusRadiant    int                      `json:"tower_status_radiant"`
+	TowerStatusDire       int                      `json:"tower_status_dire"`
+	BarracksStatusRadiant int                      `json:"barracks_status_radiant"`
+	BarracksStatusDire    int                      `json:"barracks_status_dire"`
+	Cluster               int                      `json:"cluster"`
+	FirstBloodTime        int                      `json:"first_blood_time"`
+	LobbyType             int                      `json:"lobby_type"`
+	HumanPlayers          int                      `json:"human_players"`
+	LeagueID              int                      `json:"leagueid"`
+	PositiveVotes         int                      `json:"positive_votes"`
+	NegativeVotes         int                      `json:"negative_votes"`
+	GameMode              int                      `json:"game_mode"`
+	Flags                 int                      `json:"flags"`
+	Engine                int                      `json:"engine"`
+	RadiantScore          int                      `json:"radiant_score"`
+	DireScore             int                      `json:"dire_score"`
+	Players               []DotaMatchDetailsPlayer `json:"players"`
+	PicksBans             []DotaPickBan            `json:"picks_bans"`
+}
+
+// GetDotaMatchDetails returns the full details of a single Dota 2 match. A *DotaError is returned when steam
+// reports an error, such as when the match does not exist or is not available.
+func GetDotaMatchDetails(ctx context.Context, client HTTPClientHandler, matchID uint64) (*DotaMatchDetails, error) {
+	type response struct {
+		Result struct {
+			Error string `json:"error"`
+			DotaMatchDetails
+		} `json:"result"`
+	}
+
+	var resp response
+
+	errResp := apiRequest(ctx, client, "/IDOTA2Match_570/GetMatchDetails/v1", url.Values{
+		"match_id": []string{strconv.FormatUint(matchID, 10)},
+	}, &resp)
+	if errResp != nil {
+		return nil, errResp
+	}
+
+	if resp.Result.Error != "" {
+		return nil, &DotaError{Detail: resp.Result.Error}
+	}
+
+	details := resp.Result.DotaMatchDetails
+
+	return &details, nil
+}
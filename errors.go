@@ -0,0 +1,175 @@
+package steamweb
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"syscall"
+
+	"github.com/leighmacdonald/steamid/v4/steamid"
+	"github.com/pkg/errors"
+)
+
+// StatusError is returned when steam responds with a non 200 status code. It can be inspected with errors.As
+// and also matches ErrServiceUnavailable, ErrServiceRateLimit and ErrUnauthorized with errors.Is for their
+// respective status codes.
+type StatusError struct {
+	// StatusCode is the HTTP status code received.
+	StatusCode int
+	// Path is the request path of the endpoint, eg: /ISteamUser/GetPlayerBans/v1/.
+	Path string
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("Invalid status code received: %d (%s)", e.StatusCode, e.Path)
+}
+
+// Is implements errors.Is support for the status code sentinel errors.
+func (e *StatusError) Is(target error) bool {
+	switch target { //nolint:errorlint
+	case ErrServiceUnavailable:
+		return e.StatusCode == http.StatusServiceUnavailable
+	case ErrServiceRateLimit:
+		return e.StatusCode == http.StatusTooManyRequests
+	case ErrUnauthorized:
+		return e.StatusCode == http.StatusUnauthorized
+	default:
+		return false
+	}
+}
+
+// AddressQueryError is returned by GetServersAtAddress when steam reports that the query for the address failed.
+// It matches ErrInvalidResponse with errors.Is.
+type AddressQueryError struct {
+	Addr net.IP
+}
+
+func (e *AddressQueryError) Error() string {
+	return fmt.Sprintf("Failed to query servers at address: %s", e.Addr)
+}
+
+func (e *AddressQueryError) Unwrap() error {
+	return ErrInvalidResponse
+}
+
+// IncompleteGroupError is returned by GetGroupMembers when the number of members parsed does not match the member
+// count reported by steam. The members that were parsed are still returned alongside it.
+type IncompleteGroupError struct {
+	GroupID steamid.SteamID
+	// Expected is the memberCount reported by steam.
+	Expected int
+	// Actual is the number of members that were parsed.
+	Actual int
+}
+
+func (e *IncompleteGroupError) Error() string {
+	return fmt.Sprintf("Incomplete group member list for %d: expected %d, got %d",
+		e.GroupID.Int64(), e.Expected, e.Actual)
+}
+
+// DotaError is returned by the IDOTA2Match_570 endpoints when steam reports a failure in the response body, such
+// as a private match history. It matches ErrInvalidResponse with errors.Is.
+type DotaError struct {
+	// Status is the status code reported by steam, 0 when only an error message was returned.
+	Status int
+	Detail string
+}
+
+func (e *DotaError) Error() string {
+	if e.Status == 0 {
+		return fmt.Sprintf("Dota request failed: %s", e.Detail)
+	}
+
+	return fmt.Sprintf("Dota request failed (%d): %s", e.Status, e.Detail)
+}
+
+func (e *DotaError) Unwrap() error {
+	return ErrInvalidResponse
+}
+
+// PlayerStatsError is returned by GetUserStatsForGame when steam either reports an error message in the response
+// body or fails the request with an internal server error, which the stats endpoint does for apps without stats.
+// It unwraps to ErrProfilePrivate, ErrInvalidResponse or the underlying *StatusError accordingly.
+type PlayerStatsError struct {
+	SteamID steamid.SteamID
+	AppID   steamid.AppID
+	// Message is the error reported by steam, empty when the request failed with a status code.
+	Message string
+	Err     error
+}
+
+func (e *PlayerStatsError) Error() string {
+	if e.Message == "" {
+		return fmt.Sprintf("Failed to get stats for %d (app %d): %v", e.SteamID.Int64(), e.AppID, e.Err)
+	}
+
+	return fmt.Sprintf("Failed to get stats for %d (app %d): %s", e.SteamID.Int64(), e.AppID, e.Message)
+}
+
+func (e *PlayerStatsError) Unwrap() error {
+	return e.Err
+}
+
+// TransientError wraps network level failures such as timeouts, refused or reset connections and dns lookup
+// failures which are likely to succeed if retried. The original error is available with errors.As.
+type TransientError struct {
+	Err error
+}
+
+func (e *TransientError) Error() string {
+	return fmt.Sprintf("Transient network error: %v", e.Err)
+}
+
+func (e *TransientError) Unwrap() error {
+	return e.Err
+}
+
+// wrapRequestError wraps an error returned while performing a request, marking it as a *TransientError when
+// it was caused by a network failure rather than the callers context being cancelled.
+func wrapRequestError(ctx context.Context, err error) error {
+	if ctx.Err() == nil && isTransientNetError(err) {
+		return &TransientError{Err: err}
+	}
+
+	return err
+}
+
+func isTransientNetError(err error) bool {
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) {
+		return !dnsErr.IsNotFound
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+
+	return errors.Is(err, syscall.ECONNREFUSED) ||
+		errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, syscall.EPIPE) ||
+		errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, io.EOF)
+}
+
+// IsRetryable reports whether the request that returned err is likely to succeed if tried again later. This
+// includes transient network errors, rate limiting, steam server errors and empty responses.
+func IsRetryable(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	var transientErr *TransientError
+	if errors.As(err, &transientErr) {
+		return true
+	}
+
+	var statusErr *StatusError
+	if errors.As(err, &statusErr) {
+		return statusErr.StatusCode == http.StatusTooManyRequests || statusErr.StatusCode >= http.StatusInternalServerError
+	}
+
+	return errors.Is(err, ErrServiceUnavailable) || errors.Is(err, ErrServiceRateLimit) || errors.Is(err, ErrEmptyResponse)
+}
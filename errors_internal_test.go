@@ -0,0 +1,56 @@
+package steamweb
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatusError(t *testing.T) {
+	var err error = &StatusError{StatusCode: http.StatusTooManyRequests, Path: "/ISteamUser/GetPlayerBans/v1/"}
+
+	require.ErrorIs(t, err, ErrServiceRateLimit)
+	require.NotErrorIs(t, err, ErrServiceUnavailable)
+
+	wrapped := errors.Wrap(&StatusError{StatusCode: http.StatusInternalServerError}, "wrapped")
+
+	var statusErr *StatusError
+
+	require.ErrorAs(t, wrapped, &statusErr)
+	require.Equal(t, http.StatusInternalServerError, statusErr.StatusCode)
+}
+
+func TestTransientError(t *testing.T) {
+	listener, errListen := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, errListen)
+
+	addr := listener.Addr().String()
+	require.NoError(t, listener.Close())
+
+	_, errDial := (&http.Client{}).Get("http://" + addr)
+	require.Error(t, errDial)
+
+	err := errors.Wrap(wrapRequestError(context.Background(), errDial), "Failed to perform http request")
+
+	var transientErr *TransientError
+
+	require.ErrorAs(t, err, &transientErr)
+	require.True(t, IsRetryable(err))
+
+	var netErr net.Error
+
+	require.ErrorAs(t, err, &netErr)
+
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	require.False(t, IsRetryable(wrapRequestError(cancelled, errDial)))
+	require.False(t, IsRetryable(errors.New("logic error")))
+	require.True(t, IsRetryable(&StatusError{StatusCode: http.StatusBadGateway}))
+	require.False(t, IsRetryable(&StatusError{StatusCode: http.StatusNotFound}))
+	require.True(t, IsRetryable(errors.Wrap(ErrEmptyResponse, "Failed")))
+}
@@ -0,0 +1,43 @@
+package steamweb
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGroupMemberListDecode(t *testing.T) {
+	body := []byte(`<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<memberList>
+	<groupID64>103582791429521412</groupID64>
+	<groupDetails><memberCount>3</memberCount></groupDetails>
+	<memberCount>3</memberCount>
+	<totalPages>2</totalPages>
+	<currentPage>1</currentPage>
+	<members>
+		<steamID64>76561197960287930</steamID64>
+		<steamID64>76561197985607672</steamID64>
+	</members>
+</memberList>`)
+
+	var list groupMemberList
+
+	require.NoError(t, xml.Unmarshal(body, &list))
+	require.Equal(t, 3, list.MemberCount)
+	require.Equal(t, 2, list.TotalPages)
+	require.Equal(t, 1, list.CurrentPage)
+	require.Equal(t, []string{"76561197960287930", "76561197985607672"}, list.Members)
+}
+
+func TestSetGroupRequestRate(t *testing.T) {
+	t.Cleanup(func() { SetGroupRequestRate(0) })
+
+	SetGroupRequestRate(2)
+	require.InDelta(t, 2.0, float64(groupLimiter.Limit()), 0.001)
+
+	for _, rps := range []float64{0, -1} {
+		SetGroupRequestRate(rps)
+		require.Equal(t, defaultGroupRequestRate, groupLimiter.Limit())
+	}
+}
@@ -0,0 +1,109 @@
+package steamweb
+
+import (
+	"encoding/json"
+
+	"github.com/leighmacdonald/steamid/v4/steamid"
+)
+
+// Steam is inconsistent in the casing of its response keys, eg: steamid, SteamId and steamID. The player types
+// below implement json.Marshaler so that they are always encoded with the same schema, camelCase keys with the
+// steam id encoded as a 64bit string under "steamid", regardless of the shape steam returned them in.
+//
+// Decoding accepts both the steam shape and the encoded schema. For most types this needs no extra work as
+// encoding/json matches keys case insensitively, only keys that differ by more than case need a custom
+// UnmarshalJSON.
+
+type playerSummaryJSON struct {
+	SteamID                  steamid.SteamID   `json:"steamid"`
+	CommunityVisibilityState VisibilityState   `json:"communityVisibilityState"`
+	ProfileState             ProfileState      `json:"profileState"`
+	PersonaName              string            `json:"personaName"`
+	ProfileURL               string            `json:"profileUrl"`
+	Avatar                   string            `json:"avatar"`
+	AvatarMedium             string            `json:"avatarMedium"`
+	AvatarFull               string            `json:"avatarFull"`
+	AvatarHash               string            `json:"avatarHash"`
+	PersonaState             PersonaState      `json:"personaState"`
+	RealName                 string            `json:"realName"`
+	PrimaryClanID            string            `json:"primaryClanId"`
+	TimeCreated              int               `json:"timeCreated"`
+	PersonaStateFlags        int               `json:"personaStateFlags"`
+	LocCountryCode           string            `json:"locCountryCode"`
+	LocStateCode             string            `json:"locStateCode"`
+	LocCityID                int               `json:"locCityId"`
+	LastLogoff               int               `json:"lastLogoff"`
+	CommentPermission        CommentPermission `json:"commentPermission"`
+	GameID                   string            `json:"gameId,omitempty"`
+	GameExtraInfo            string            `json:"gameExtraInfo,omitempty"`
+	GameServerIP             string            `json:"gameServerIp,omitempty"`
+}
+
+// MarshalJSON encodes the summary using camelCase keys.
+func (p PlayerSummary) MarshalJSON() ([]byte, error) {
+	return json.Marshal(playerSummaryJSON(p))
+}
+
+type playerBanStateJSON struct {
+	SteamID          steamid.SteamID `json:"steamid"`
+	CommunityBanned  bool            `json:"communityBanned"`
+	VACBanned        bool            `json:"vacBanned"`
+	NumberOfVACBans  int             `json:"numberOfVacBans"`
+	DaysSinceLastBan int             `json:"daysSinceLastBan"`
+	NumberOfGameBans int             `json:"numberOfGameBans"`
+	EconomyBan       EconBanState    `json:"economyBan"`
+}
+
+// MarshalJSON encodes the ban state using camelCase keys.
+func (s PlayerBanState) MarshalJSON() ([]byte, error) {
+	return json.Marshal(playerBanStateJSON(s))
+}
+
+type friendJSON struct {
+	SteamID      steamid.SteamID `json:"steamid"`
+	Relationship string          `json:"relationship"`
+	FriendSince  int             `json:"friendSince"`
+}
+
+// MarshalJSON encodes the friend using camelCase keys.
+func (f Friend) MarshalJSON() ([]byte, error) {
+	return json.Marshal(friendJSON(f))
+}
+
+// UnmarshalJSON decodes both the steam friend_since and the encoded friendSince keys.
+func (f *Friend) UnmarshalJSON(data []byte) error {
+	var value struct {
+		friendJSON
+		SteamFriendSince *int `json:"friend_since"`
+	}
+
+	if errUnmarshal := json.Unmarshal(data, &value); errUnmarshal != nil {
+		return errUnmarshal //nolint:wrapcheck
+	}
+
+	*f = Friend(value.friendJSON)
+
+	if value.SteamFriendSince != nil {
+		f.FriendSince = *value.SteamFriendSince
+	}
+
+	return nil
+}
+
+type playerStatsJSON struct {
+	SteamID  steamid.SteamID `json:"steamid"`
+	GameName string          `json:"gameName"`
+	Stats    []struct {
+		Name  string `json:"name"`
+		Value int    `json:"value"`
+	} `json:"stats"`
+	Achievements []struct {
+		Name     string `json:"name"`
+		Achieved int    `json:"achieved"`
+	} `json:"achievements"`
+}
+
+// MarshalJSON encodes the stats using camelCase keys.
+func (s PlayerStats) MarshalJSON() ([]byte, error) {
+	return json.Marshal(playerStatsJSON(s))
+}
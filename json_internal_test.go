@@ -0,0 +1,69 @@
+package steamweb
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// roundTrip decodes the steam shaped input, encodes it and decodes the encoded form again, checking that the
+// encoded keys match the expected schema and that nothing is lost along the way.
+func roundTrip[T any](t *testing.T, steamJSON string, keys ...string) T {
+	t.Helper()
+
+	var fromSteam T
+
+	require.NoError(t, json.Unmarshal([]byte(steamJSON), &fromSteam))
+
+	encoded, errMarshal := json.Marshal(fromSteam)
+	require.NoError(t, errMarshal)
+
+	var fields map[string]any
+
+	require.NoError(t, json.Unmarshal(encoded, &fields))
+
+	for _, key := range keys {
+		require.Contains(t, fields, key)
+	}
+
+	require.IsType(t, "", fields["steamid"])
+
+	var decoded T
+
+	require.NoError(t, json.Unmarshal(encoded, &decoded))
+	require.Equal(t, fromSteam, decoded)
+
+	return decoded
+}
+
+func TestPlayerSummaryJSON(t *testing.T) {
+	summary := roundTrip[PlayerSummary](t, `{"steamid":"76561197960287930","communityvisibilitystate":3,
+		"profilestate":1,"personaname":"Rabscuttle","profileurl":"https://steamcommunity.com/id/GabeLoganNewell/",
+		"personastate":1,"primaryclanid":"103582791429521408","timecreated":1063407589,"loccountrycode":"US",
+		"gameid":"440","gameextrainfo":"Team Fortress 2"}`,
+		"steamid", "communityVisibilityState", "personaName", "profileUrl", "primaryClanId", "gameExtraInfo")
+	require.Equal(t, "Rabscuttle", summary.PersonaName)
+	require.Equal(t, int64(76561197960287930), summary.SteamID.Int64())
+}
+
+func TestPlayerBanStateJSON(t *testing.T) {
+	bans := roundTrip[PlayerBanState](t, `{"SteamId":"76561197960287930","CommunityBanned":false,"VACBanned":true,
+		"NumberOfVACBans":1,"DaysSinceLastBan":12,"NumberOfGameBans":0,"EconomyBan":"none"}`,
+		"steamid", "vacBanned", "numberOfVacBans", "daysSinceLastBan", "economyBan")
+	require.True(t, bans.VACBanned)
+	require.Equal(t, 12, bans.DaysSinceLastBan)
+}
+
+func TestFriendJSON(t *testing.T) {
+	friend := roundTrip[Friend](t, `{"steamid":"76561197960287930","relationship":"friend","friend_since":1300000000}`,
+		"steamid", "relationship", "friendSince")
+	require.Equal(t, 1300000000, friend.FriendSince)
+}
+
+func TestPlayerStatsJSON(t *testing.T) {
+	stats := roundTrip[PlayerStats](t, `{"steamID":"76561197960287930","gameName":"Team Fortress 2",
+		"stats":[{"name":"Scout.accum.iNumberOfKills","value":10}],"achievements":[{"name":"TF_PLAY_GAME_EVERYCLASS","achieved":1}]}`,
+		"steamid", "gameName", "stats", "achievements")
+	require.Len(t, stats.Stats, 1)
+}
@@ -0,0 +1,117 @@
+package steamweb
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/leighmacdonald/steamid/v4/steamid"
+	"github.com/pkg/errors"
+)
+
+// keyProbeSteamID is a long-standing public profile used to check standard key access.
+const keyProbeSteamID = "76561197960287930"
+
+// PartnerApp is an app the key's publisher account has access to.
+type PartnerApp struct {
+	AppID   steamid.AppID `json:"appid"`
+	AppType string        `json:"app_type"`
+	AppName string        `json:"app_name"`
+}
+
+// KeyInfo describes what the current api key is able to access, as reported by KeyCapabilities.
+type KeyInfo struct {
+	// Standard is true when the key is accepted by the public endpoints.
+	Standard bool
+	// Publisher is true when the key is accepted by publisher only endpoints.
+	Publisher bool
+	// PartnerApps holds the apps the publisher key has access to. It is only populated for publisher keys.
+	PartnerApps []PartnerApp
+}
+
+// KeyCapabilities probes representative endpoints to determine which tiers of the api the current key has access
+// to, a public endpoint, GetPlayerSummaries, and a publisher only endpoint, GetCheatingReports. For publisher keys
+// the partner app list is also fetched. Rejected keys are reported in the returned KeyInfo rather than as an
+// error, errors are only returned when a probe could not be completed, eg: due to a network failure. This is
+// intended to be called at startup so that services lacking a required scope can fail fast.
+func KeyCapabilities(ctx context.Context, client HTTPClientHandler) (*KeyInfo, error) {
+	info := &KeyInfo{}
+
+	standard, errStandard := probeKeyAccess(ctx, client, "/ISteamUser/GetPlayerSummaries/v0002/", url.Values{
+		"steamids": []string{keyProbeSteamID},
+	})
+	if errStandard != nil {
+		return nil, errStandard
+	}
+
+	info.Standard = standard
+
+	if !standard {
+		return info, nil
+	}
+
+	publisher, errPublisher := probeKeyAccess(ctx, client, "/ICheatReportingService/GetCheatingReports/v1", url.Values{})
+	if errPublisher != nil {
+		return nil, errPublisher
+	}
+
+	info.Publisher = publisher
+
+	if !publisher {
+		return info, nil
+	}
+
+	apps, errApps := getPartnerAppList(ctx, client)
+	if errApps != nil {
+		return nil, errApps
+	}
+
+	info.PartnerApps = apps
+
+	return info, nil
+}
+
+// probeKeyAccess performs a request against the endpoint and reports whether the key was accepted. Requests
+// rejected for other reasons, such as missing parameters, still indicate that the key has access.
+func probeKeyAccess(ctx context.Context, client HTTPClientHandler, path string, values url.Values) (bool, error) {
+	var resp any
+
+	errResp := apiRequest(ctx, client, path, values, &resp)
+	if errResp == nil {
+		return true, nil
+	}
+
+	var statusErr *StatusError
+	if !errors.As(errResp, &statusErr) {
+		return false, errResp
+	}
+
+	switch statusErr.StatusCode {
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return false, nil
+	case http.StatusBadRequest:
+		return true, nil
+	default:
+		return false, errResp
+	}
+}
+
+// getPartnerAppList fetches the apps a publisher key has access to.
+func getPartnerAppList(ctx context.Context, client HTTPClientHandler) ([]PartnerApp, error) {
+	type response struct {
+		AppList struct {
+			Apps struct {
+				App []PartnerApp `json:"app"`
+			} `json:"apps"`
+		} `json:"applist"`
+	}
+
+	var resp response
+
+	errResp := apiRequest(ctx, client, "/ISteamApps/GetPartnerAppListForWebAPIKey/v2", url.Values{}, &resp)
+	if errResp != nil {
+		return nil, errResp
+	}
+
+	return resp.AppList.Apps.App, nil
+}
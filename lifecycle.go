@@ -0,0 +1,88 @@
+package steamweb
+
+import (
+	"context"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// ErrShutdown is returned for any request made after Shutdown has been called.
+var ErrShutdown = errors.New("Shutdown")
+
+var lifecycle = newLifecycleState() //nolint:gochecknoglobals
+
+// lifecycleState tracks the in-flight requests and background goroutines so that they can be stopped and
+// waited on by Shutdown.
+type lifecycleState struct {
+	mu        sync.Mutex
+	closed    bool
+	waitGroup sync.WaitGroup
+	// ctx is cancelled on shutdown to stop any background goroutines.
+	ctx    context.Context //nolint:containedctx
+	cancel context.CancelFunc
+}
+
+func newLifecycleState() *lifecycleState {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	return &lifecycleState{ctx: ctx, cancel: cancel}
+}
+
+// acquire registers an in-flight request or background goroutine. It must be paired with a call to release.
+// ErrShutdown is returned once shutdown has started.
+func (l *lifecycleState) acquire() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.closed {
+		return ErrShutdown
+	}
+
+	l.waitGroup.Add(1)
+
+	return nil
+}
+
+func (l *lifecycleState) release() {
+	l.waitGroup.Done()
+}
+
+// background returns a copy of ctx that is also cancelled on shutdown.
+func (l *lifecycleState) background(ctx context.Context) (context.Context, context.CancelFunc) {
+	bgCtx, cancel := context.WithCancel(ctx)
+	stop := context.AfterFunc(l.ctx, cancel)
+
+	return bgCtx, func() {
+		stop()
+		cancel()
+	}
+}
+
+func (l *lifecycleState) shutdown(ctx context.Context) error {
+	l.mu.Lock()
+	l.closed = true
+	l.cancel()
+	l.mu.Unlock()
+
+	done := make(chan struct{})
+
+	go func() {
+		l.waitGroup.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return errors.Wrap(ctx.Err(), "Shutdown did not complete")
+	}
+}
+
+// Shutdown stops any background work started by the package, such as StartPlayerCountSampler and
+// StartAppListRefresh, and waits for in-flight requests to complete until the context expires. Once called, all
+// requests fail with ErrShutdown.
+func Shutdown(ctx context.Context) error {
+	return lifecycle.shutdown(ctx)
+}
@@ -0,0 +1,35 @@
+package steamweb
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLifecycleShutdown(t *testing.T) {
+	state := newLifecycleState()
+
+	require.NoError(t, state.acquire())
+
+	bgCtx, cancel := state.background(context.Background())
+	defer cancel()
+
+	timeoutCtx, cancelTimeout := context.WithTimeout(context.Background(), time.Millisecond*10)
+	defer cancelTimeout()
+
+	require.Error(t, state.shutdown(timeoutCtx), "in-flight request should block shutdown")
+
+	select {
+	case <-bgCtx.Done():
+	case <-time.After(time.Second):
+		t.Fatal("background context not cancelled")
+	}
+
+	require.ErrorIs(t, state.acquire(), ErrShutdown)
+
+	state.release()
+
+	require.NoError(t, state.shutdown(context.Background()))
+}
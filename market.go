@@ -0,0 +1,123 @@
+package steamweb
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/leighmacdonald/steamid/v4/steamid"
+	"github.com/pkg/errors"
+)
+
+// ErrLoginRequired is returned by endpoints that require a logged-in steam community session when the
+// HTTPClientHandler does not provide one.
+var ErrLoginRequired = errors.New("Steam community login required")
+
+// priceHistoryTimeLayout is the format of price history timestamps once the trailing ": +0" is removed.
+const priceHistoryTimeLayout = "Jan 02 2006 15"
+
+// PricePoint is the median sale price and number of sales of an item over a period of time.
+type PricePoint struct {
+	Time time.Time `json:"time"`
+	// Median is the median sale price in the currency of the logged-in account.
+	Median float64 `json:"median"`
+	Volume int     `json:"volume"`
+}
+
+// GetMarketPriceHistory fetches the sale price history of a community market item.
+//
+// This endpoint does not use the steam api key, instead it requires a logged-in steam community session. The
+// HTTPClientHandler must send the steamLoginSecure cookie, eg: by using a http.Client with a cookie jar containing
+// it. ErrLoginRequired is returned when steam does not accept the session.
+func GetMarketPriceHistory(ctx context.Context, client HTTPClientHandler, appID steamid.AppID, marketHashName string) ([]PricePoint, error) {
+	type response struct {
+		Success bool `json:"success"`
+		// Prices are sent as a list of [time, median price, volume] tuples.
+		Prices [][]json.RawMessage `json:"prices"`
+	}
+
+	body, errFetch := fetchRaw(ctx, client, "https://steamcommunity.com/market/pricehistory/?"+url.Values{
+		"appid":            []string{fmt.Sprintf("%d", appID)},
+		"market_hash_name": []string{marketHashName},
+	}.Encode())
+	if errFetch != nil {
+		var statusErr *StatusError
+		if errors.As(errFetch, &statusErr) &&
+			(statusErr.StatusCode == http.StatusBadRequest || statusErr.StatusCode == http.StatusForbidden) {
+			return nil, ErrLoginRequired
+		}
+
+		return nil, errFetch
+	}
+
+	// Steam responds with an empty array instead of an object when the session is missing.
+	if strings.HasPrefix(strings.TrimSpace(string(body)), "[") {
+		return nil, ErrLoginRequired
+	}
+
+	var resp response
+	if errUnmarshal := json.Unmarshal(body, &resp); errUnmarshal != nil {
+		return nil, errors.Wrap(errUnmarshal, "Failed to decode JSON response")
+	}
+
+	if !resp.Success {
+		return nil, ErrInvalidResponse
+	}
+
+	points := make([]PricePoint, len(resp.Prices))
+
+	for index, price := range resp.Prices {
+		point, errPoint := parsePricePoint(price)
+		if errPoint != nil {
+			return nil, errPoint
+		}
+
+		points[index] = point
+	}
+
+	return points, nil
+}
+
+func parsePricePoint(price []json.RawMessage) (PricePoint, error) {
+	const priceFields = 3
+
+	if len(price) != priceFields {
+		return PricePoint{}, errors.Wrap(ErrInvalidResponse, "Invalid price history entry")
+	}
+
+	var (
+		rawTime   string
+		median    float64
+		rawVolume string
+	)
+
+	if err := json.Unmarshal(price[0], &rawTime); err != nil {
+		return PricePoint{}, errors.Wrap(err, "Invalid price history time")
+	}
+
+	if err := json.Unmarshal(price[1], &median); err != nil {
+		return PricePoint{}, errors.Wrap(err, "Invalid price history median")
+	}
+
+	if err := json.Unmarshal(price[2], &rawVolume); err != nil {
+		return PricePoint{}, errors.Wrap(err, "Invalid price history volume")
+	}
+
+	// eg: Jul 02 2014 01: +0
+	pointTime, errTime := time.Parse(priceHistoryTimeLayout, strings.TrimSpace(strings.Split(rawTime, ":")[0]))
+	if errTime != nil {
+		return PricePoint{}, errors.Wrap(errTime, "Invalid price history time")
+	}
+
+	volume, errVolume := strconv.Atoi(rawVolume)
+	if errVolume != nil {
+		return PricePoint{}, errors.Wrap(errVolume, "Invalid price history volume")
+	}
+
+	return PricePoint{Time: pointTime, Median: median, Volume: volume}, nil
+}
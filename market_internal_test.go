@@ -0,0 +1,22 @@
+package steamweb
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePricePoint(t *testing.T) {
+	point, err := parsePricePoint([]json.RawMessage{
+		json.RawMessage(`"Jul 02 2014 01: +0"`), json.RawMessage(`417.777`), json.RawMessage(`"40"`),
+	})
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2014, time.July, 2, 1, 0, 0, 0, time.UTC), point.Time)
+	require.InDelta(t, 417.777, point.Median, 0.0001)
+	require.Equal(t, 40, point.Volume)
+
+	_, errShort := parsePricePoint([]json.RawMessage{json.RawMessage(`"Jul 02 2014 01: +0"`)})
+	require.ErrorIs(t, errShort, ErrInvalidResponse)
+}
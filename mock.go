@@ -0,0 +1,119 @@
+package steamweb
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+)
+
+// MockClient is a HTTPClientHandler that never touches the network, intended for testing code that uses this
+// package. Requests are either served by a http.Handler, allowing canned responses to be returned, or blocked
+// until their context is done to exercise timeout and cancellation handling. Requests made using a MockClient, or
+// a type embedding one, do not require an api key to be set with SetKey.
+type MockClient struct {
+	handler  http.Handler
+	mu       sync.Mutex
+	requests []*http.Request
+}
+
+// NewMockClient returns a MockClient which serves every request using handler. The request url is the real
+// steam url, so handlers can route using the request path, eg: /ISteamUser/GetPlayerBans/v1.
+func NewMockClient(handler http.Handler) *MockClient {
+	return &MockClient{handler: handler}
+}
+
+// NewBlockingClient returns a MockClient which blocks every request until its context is cancelled or reaches
+// its deadline, then returns the context error.
+func NewBlockingClient() *MockClient {
+	return &MockClient{}
+}
+
+// Do implements HTTPClientHandler.
+func (c *MockClient) Do(req *http.Request) (*http.Response, error) {
+	c.mu.Lock()
+	c.requests = append(c.requests, req)
+	c.mu.Unlock()
+
+	if c.handler == nil {
+		<-req.Context().Done()
+
+		return nil, req.Context().Err()
+	}
+
+	if errCtx := req.Context().Err(); errCtx != nil {
+		return nil, errCtx
+	}
+
+	writer := &mockResponseWriter{header: http.Header{}}
+	c.handler.ServeHTTP(writer, req)
+
+	return writer.response(req), nil
+}
+
+// keyOptional reports that requests served by the client do not need an api key.
+func (c *MockClient) keyOptional() bool {
+	return true
+}
+
+// Requests returns every request received by the client, in order.
+func (c *MockClient) Requests() []*http.Request {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return append([]*http.Request(nil), c.requests...)
+}
+
+// Reset discards the requests received so far.
+func (c *MockClient) Reset() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.requests = nil
+}
+
+// mockResponseWriter is a minimal http.ResponseWriter which buffers the response written by a handler.
+type mockResponseWriter struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+func (w *mockResponseWriter) Header() http.Header {
+	return w.header
+}
+
+func (w *mockResponseWriter) Write(buf []byte) (int, error) {
+	if w.status == 0 {
+		w.status = http.StatusOK
+	}
+
+	return w.body.Write(buf) //nolint:wrapcheck
+}
+
+func (w *mockResponseWriter) WriteHeader(status int) {
+	if w.status == 0 {
+		w.status = status
+	}
+}
+
+// response converts the buffered response into a http.Response for the request.
+func (w *mockResponseWriter) response(req *http.Request) *http.Response {
+	status := w.status
+	if status == 0 {
+		status = http.StatusOK
+	}
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", status, http.StatusText(status)),
+		StatusCode:    status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        w.header.Clone(),
+		Body:          io.NopCloser(bytes.NewReader(w.body.Bytes())),
+		ContentLength: int64(w.body.Len()),
+		Request:       req,
+	}
+}
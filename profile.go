@@ -0,0 +1,159 @@
+package steamweb
+
+import (
+	"context"
+	"sync"
+
+	"github.com/leighmacdonald/steamid/v4/steamid"
+	"github.com/pkg/errors"
+)
+
+// ProfileSection identifies a part of a PlayerProfile fetched by GetPlayerProfile.
+type ProfileSection string
+
+// ProfileSection options
+//
+//goland:noinspection ALL
+const (
+	ProfileSectionSummary    ProfileSection = "summary"
+	ProfileSectionBans       ProfileSection = "bans"
+	ProfileSectionLevel      ProfileSection = "level"
+	ProfileSectionBadges     ProfileSection = "badges"
+	ProfileSectionOwnedGames ProfileSection = "owned_games"
+)
+
+// ProfileOptions selects which sections are fetched by GetPlayerProfile. The summary is always fetched.
+type ProfileOptions struct {
+	Bans       bool
+	Level      bool
+	Badges     bool
+	OwnedGames bool
+}
+
+// PlayerProfile combines the results of several endpoints for a single user. Sections that were not requested
+// or failed are left empty, with the reason for any failure recorded in Errors.
+type PlayerProfile struct {
+	SteamID steamid.SteamID `json:"steam_id"`
+	Summary *PlayerSummary  `json:"summary"`
+	Bans    *PlayerBanState `json:"bans"`
+	// Level is -1 when it was not fetched.
+	Level      int          `json:"level"`
+	Badges     *BadgeStatus `json:"badges"`
+	OwnedGames []OwnedGame  `json:"owned_games"`
+	// Errors contains the error of every section that could not be fetched, eg: ErrProfilePrivate.
+	Errors map[ProfileSection]error `json:"-"`
+}
+
+// GetPlayerProfile fetches the summary and any sections enabled in opts for a user concurrently. A failure
+// fetching one section, such as a private game list, does not fail the others, instead the error is recorded
+// in PlayerProfile.Errors. An error is only returned for an invalid steam id.
+func GetPlayerProfile(ctx context.Context, client HTTPClientHandler, steamID steamid.SteamID, opts ProfileOptions) (*PlayerProfile, error) {
+	if !steamID.Valid() {
+		return nil, errors.Wrap(steamid.ErrInvalidSID, steamID.String())
+	}
+
+	var (
+		profile   = &PlayerProfile{SteamID: steamID, Level: -1, Errors: map[ProfileSection]error{}}
+		profileMu sync.Mutex
+		waitGroup sync.WaitGroup
+	)
+
+	fetch := func(section ProfileSection, enabled bool, fn func() error) {
+		if !enabled {
+			return
+		}
+
+		waitGroup.Add(1)
+
+		go func() {
+			defer waitGroup.Done()
+
+			if err := fn(); err != nil {
+				profileMu.Lock()
+				profile.Errors[section] = err
+				profileMu.Unlock()
+			}
+		}()
+	}
+
+	fetch(ProfileSectionSummary, true, func() error {
+		summaries, err := PlayerSummaries(ctx, client, steamid.Collection{steamID})
+		if err != nil {
+			return err
+		}
+
+		if len(summaries) == 0 {
+			return ErrInvalidResponse
+		}
+
+		profileMu.Lock()
+		profile.Summary = &summaries[0]
+		profileMu.Unlock()
+
+		return nil
+	})
+
+	fetch(ProfileSectionBans, opts.Bans, func() error {
+		bans, err := GetPlayerBans(ctx, client, steamid.Collection{steamID})
+		if err != nil {
+			return err
+		}
+
+		if len(bans) == 0 {
+			return ErrInvalidResponse
+		}
+
+		profileMu.Lock()
+		profile.Bans = &bans[0]
+		profileMu.Unlock()
+
+		return nil
+	})
+
+	fetch(ProfileSectionLevel, opts.Level, func() error {
+		level, err := GetSteamLevel(ctx, client, steamID)
+		if err != nil {
+			return err
+		}
+
+		profileMu.Lock()
+		profile.Level = level
+		profileMu.Unlock()
+
+		return nil
+	})
+
+	fetch(ProfileSectionBadges, opts.Badges, func() error {
+		badges, err := GetBadges(ctx, client, steamID)
+		if err != nil {
+			return err
+		}
+
+		profileMu.Lock()
+		profile.Badges = badges
+		profileMu.Unlock()
+
+		return nil
+	})
+
+	fetch(ProfileSectionOwnedGames, opts.OwnedGames, func() error {
+		games, private, err := getOwnedGames(ctx, client, steamID, nil)
+		if err != nil {
+			return err
+		}
+
+		if private {
+			return ErrProfilePrivate
+		}
+
+		profileMu.Lock()
+		profile.OwnedGames = games
+		profileMu.Unlock()
+
+		return nil
+	})
+
+	waitGroup.Wait()
+
+	return profile, nil
+}
@@ -0,0 +1,186 @@
+package steamweb
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// RetryPolicy controls how failed api requests are retried. Only errors where IsRetryable reports true
+// are retried.
+type RetryPolicy struct {
+	// MaxRetries is the maximum number of times a single request is retried.
+	MaxRetries int
+	// BaseDelay is the delay before the first retry, it is doubled for every subsequent retry.
+	BaseDelay time.Duration
+	// MaxDelay caps the delay between retries. Zero means no cap, other than the maximum time.Duration.
+	MaxDelay time.Duration
+}
+
+// delay returns the backoff before the given retry, starting at 1.
+func (p RetryPolicy) delay(retry int) time.Duration {
+	delay := p.BaseDelay
+
+	for i := 1; i < retry; i++ {
+		if (p.MaxDelay > 0 && delay >= p.MaxDelay) || delay > math.MaxInt64/2 {
+			break
+		}
+
+		delay *= 2
+	}
+
+	if p.MaxDelay > 0 && delay > p.MaxDelay {
+		return p.MaxDelay
+	}
+
+	return delay
+}
+
+var (
+	retryMu     sync.RWMutex        //nolint:gochecknoglobals
+	retryPolicy *RetryPolicy        //nolint:gochecknoglobals
+	retryStats  = newRetryCounter() //nolint:gochecknoglobals
+)
+
+// SetRetryPolicy enables retrying of failed api requests. Passing nil disables retries, which is the default.
+// Retries are performed in addition to any retry logic implemented by the HTTPClientHandler.
+func SetRetryPolicy(policy *RetryPolicy) {
+	retryMu.Lock()
+	defer retryMu.Unlock()
+
+	if policy == nil {
+		retryPolicy = nil
+
+		return
+	}
+
+	policyCopy := *policy
+	retryPolicy = &policyCopy
+}
+
+func currentRetryPolicy() *RetryPolicy {
+	retryMu.RLock()
+	defer retryMu.RUnlock()
+
+	return retryPolicy
+}
+
+// RetryStatistics describes the retries performed since the process started or ResetRetryStats was called.
+type RetryStatistics struct {
+	// Total is the number of retries performed.
+	Total int64
+	// ByStatus counts retries by the HTTP status code of the failed attempt. Network errors are counted under 0.
+	ByStatus map[int]int64
+}
+
+type retryCounter struct {
+	mu       sync.Mutex
+	total    int64
+	byStatus map[int]int64
+}
+
+func newRetryCounter() *retryCounter {
+	return &retryCounter{byStatus: map[int]int64{}}
+}
+
+func (c *retryCounter) record(err error) {
+	status := 0
+
+	var statusErr *StatusError
+	if errors.As(err, &statusErr) {
+		status = statusErr.StatusCode
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.total++
+	c.byStatus[status]++
+}
+
+func (c *retryCounter) stats() RetryStatistics {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	byStatus := make(map[int]int64, len(c.byStatus))
+	for status, count := range c.byStatus {
+		byStatus[status] = count
+	}
+
+	return RetryStatistics{Total: c.total, ByStatus: byStatus}
+}
+
+func (c *retryCounter) reset() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.total = 0
+	c.byStatus = map[int]int64{}
+}
+
+// RetryStats returns the number of retries performed by the retry policy set with SetRetryPolicy.
+func RetryStats() RetryStatistics {
+	return retryStats.stats()
+}
+
+// ResetRetryStats resets the counters returned by RetryStats.
+func ResetRetryStats() {
+	retryStats.reset()
+}
+
+// RetryError is returned when a request still failed after being retried. It unwraps to the error of the
+// final attempt so errors.Is and errors.As continue to work.
+type RetryError struct {
+	// Retries is the number of retries performed, not including the initial attempt.
+	Retries int
+	Err     error
+}
+
+func (e *RetryError) Error() string {
+	return fmt.Sprintf("Request failed after %d retries: %v", e.Retries, e.Err)
+}
+
+func (e *RetryError) Unwrap() error {
+	return e.Err
+}
+
+// withRetry calls fn, retrying it with backoff according to the current retry policy.
+func withRetry(ctx context.Context, fn func() error) error {
+	policy := currentRetryPolicy()
+
+	err := fn()
+	if err == nil || policy == nil {
+		return err
+	}
+
+	retries := 0
+
+	for ; retries < policy.MaxRetries && IsRetryable(err); retries++ {
+		retryStats.record(err)
+		debugf("Retrying request (%d/%d): %v\n", retries+1, policy.MaxRetries, err)
+
+		timer := time.NewTimer(policy.delay(retries + 1))
+
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+
+			return &RetryError{Retries: retries, Err: err}
+		case <-timer.C:
+		}
+
+		if err = fn(); err == nil {
+			return nil
+		}
+	}
+
+	if retries == 0 {
+		return err
+	}
+
+	return &RetryError{Retries: retries, Err: err}
+}
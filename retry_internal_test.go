@@ -0,0 +1,84 @@
+package steamweb
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithRetry(t *testing.T) {
+	SetRetryPolicy(&RetryPolicy{MaxRetries: 3, BaseDelay: time.Millisecond, MaxDelay: time.Millisecond * 2})
+	defer SetRetryPolicy(nil)
+
+	ResetRetryStats()
+	defer ResetRetryStats()
+
+	attempts := 0
+	err := withRetry(context.Background(), func() error {
+		attempts++
+		if attempts < 3 {
+			return &StatusError{StatusCode: http.StatusServiceUnavailable}
+		}
+
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, 3, attempts)
+
+	stats := RetryStats()
+	require.Equal(t, int64(2), stats.Total)
+	require.Equal(t, int64(2), stats.ByStatus[http.StatusServiceUnavailable])
+
+	attempts = 0
+	errFailed := withRetry(context.Background(), func() error {
+		attempts++
+
+		return &StatusError{StatusCode: http.StatusTooManyRequests}
+	})
+
+	var retryErr *RetryError
+
+	require.ErrorAs(t, errFailed, &retryErr)
+	require.Equal(t, 3, retryErr.Retries)
+	require.Equal(t, 4, attempts)
+	require.ErrorIs(t, errFailed, ErrServiceRateLimit)
+
+	attempts = 0
+	errLogic := errors.New("logic")
+	require.Equal(t, errLogic, withRetry(context.Background(), func() error {
+		attempts++
+
+		return errLogic
+	}))
+	require.Equal(t, 1, attempts)
+
+	SetRetryPolicy(nil)
+
+	attempts = 0
+	_ = withRetry(context.Background(), func() error {
+		attempts++
+
+		return &StatusError{StatusCode: http.StatusServiceUnavailable}
+	})
+	require.Equal(t, 1, attempts)
+}
+
+func TestRetryPolicyDelay(t *testing.T) {
+	policy := RetryPolicy{BaseDelay: time.Second, MaxDelay: time.Second * 5}
+
+	require.Equal(t, time.Second, policy.delay(1))
+	require.Equal(t, time.Second*4, policy.delay(3))
+	require.Equal(t, time.Second*5, policy.delay(4))
+	require.Equal(t, time.Second*5, policy.delay(100))
+
+	uncapped := RetryPolicy{BaseDelay: time.Second}
+
+	require.Equal(t, time.Second*8, uncapped.delay(4))
+	require.Positive(t, uncapped.delay(34))
+	require.Positive(t, uncapped.delay(1000))
+	require.Equal(t, uncapped.delay(64), uncapped.delay(1000))
+}
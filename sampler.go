@@ -0,0 +1,163 @@
+package steamweb
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/leighmacdonald/steamid/v4/steamid"
+)
+
+const defaultSampleInterval = time.Minute
+
+// PlayerCountSample is a single timestamped result from a player count sampler.
+type PlayerCountSample struct {
+	AppID steamid.AppID
+	Count int
+	Time  time.Time
+	// Err is set when the request for this sample failed, Count will be 0.
+	Err error
+}
+
+// StartPlayerCountSampler polls GetNumberOfCurrentPlayers for the app every interval, starting immediately, and
+// emits each result on the returned channel. The channel is closed once the context is cancelled or Shutdown is
+// called. Rate limiting is handled by the client as with any other request, an interval <= 0 uses a default of
+// 1 minute.
+func StartPlayerCountSampler(ctx context.Context, client HTTPClientHandler, appID steamid.AppID, interval time.Duration) <-chan PlayerCountSample {
+	if interval <= 0 {
+		interval = defaultSampleInterval
+	}
+
+	samples := make(chan PlayerCountSample, 1)
+
+	if errAcquire := lifecycle.acquire(); errAcquire != nil {
+		close(samples)
+
+		return samples
+	}
+
+	ctx, cancel := lifecycle.background(ctx)
+
+	go func() {
+		defer func() {
+			cancel()
+			close(samples)
+			lifecycle.release()
+		}()
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			count, errCount := GetNumberOfCurrentPlayers(ctx, client, appID)
+			if ctx.Err() != nil {
+				return
+			}
+
+			select {
+			case samples <- PlayerCountSample{AppID: appID, Count: count, Time: time.Now(), Err: errCount}:
+			case <-ctx.Done():
+				return
+			}
+
+			select {
+			case <-ticker.C:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return samples
+}
+
+// PlayerCountStore receives the player counts recorded by RecordPlayerCounts. Implementations can persist them,
+// eg: to a time series database. Record is called from a single goroutine per recorder.
+type PlayerCountStore interface {
+	Record(appID steamid.AppID, count int, t time.Time)
+}
+
+// RecordPlayerCounts runs StartPlayerCountSampler and writes every successful sample to store. Failed samples are
+// skipped, they are logged when debug output is enabled with SetDebug. The returned channel is closed once
+// recording stops, when the context is cancelled or Shutdown is called.
+func RecordPlayerCounts(ctx context.Context, client HTTPClientHandler, appID steamid.AppID, interval time.Duration,
+	store PlayerCountStore,
+) <-chan struct{} {
+	samples := StartPlayerCountSampler(ctx, client, appID, interval)
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+
+		for sample := range samples {
+			if sample.Err != nil {
+				debugf("Failed to sample player count for %d: %v\n", sample.AppID, sample.Err)
+
+				continue
+			}
+
+			store.Record(sample.AppID, sample.Count, sample.Time)
+		}
+	}()
+
+	return done
+}
+
+// PlayerCountRing is an in memory PlayerCountStore that keeps the most recent samples for each app in a
+// fixed size ring buffer. It is safe for concurrent use.
+type PlayerCountRing struct {
+	mu   sync.RWMutex
+	size int
+	apps map[steamid.AppID]*playerCountBuffer
+}
+
+type playerCountBuffer struct {
+	samples []PlayerCountSample
+	// next is the index the next sample is written to once the buffer is full.
+	next int
+}
+
+// NewPlayerCountRing returns a PlayerCountRing which keeps up to size samples per app. A size of less than 1
+// is treated as 1.
+func NewPlayerCountRing(size int) *PlayerCountRing {
+	return &PlayerCountRing{size: max(size, 1), apps: map[steamid.AppID]*playerCountBuffer{}}
+}
+
+// Record implements PlayerCountStore, overwriting the oldest sample for the app once the buffer is full.
+func (r *PlayerCountRing) Record(appID steamid.AppID, count int, t time.Time) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	buffer, found := r.apps[appID]
+	if !found {
+		buffer = &playerCountBuffer{samples: make([]PlayerCountSample, 0, r.size)}
+		r.apps[appID] = buffer
+	}
+
+	sample := PlayerCountSample{AppID: appID, Count: count, Time: t}
+
+	if len(buffer.samples) < r.size {
+		buffer.samples = append(buffer.samples, sample)
+
+		return
+	}
+
+	buffer.samples[buffer.next] = sample
+	buffer.next = (buffer.next + 1) % r.size
+}
+
+// Samples returns the stored samples for the app, oldest first.
+func (r *PlayerCountRing) Samples(appID steamid.AppID) []PlayerCountSample {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	buffer, found := r.apps[appID]
+	if !found {
+		return nil
+	}
+
+	samples := make([]PlayerCountSample, 0, len(buffer.samples))
+	samples = append(samples, buffer.samples[buffer.next:]...)
+
+	return append(samples, buffer.samples[:buffer.next]...)
+}
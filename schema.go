@@ -0,0 +1,58 @@
+package steamweb
+
+import (
+	"slices"
+	"strings"
+)
+
+// SchemaItemPredicate reports whether a SchemaItem should be kept by FilterSchemaItems.
+type SchemaItemPredicate func(item SchemaItem) bool
+
+// FilterSchemaItems returns a new slice containing the items which match every predicate. With no predicates
+// all items are returned. The input slice is not modified, so it is safe to use with the shared slice returned
+// by GetSchemaItems.
+func FilterSchemaItems(items []SchemaItem, predicates ...SchemaItemPredicate) []SchemaItem {
+	filtered := make([]SchemaItem, 0, len(items))
+
+	for _, item := range items {
+		matched := true
+
+		for _, predicate := range predicates {
+			if !predicate(item) {
+				matched = false
+
+				break
+			}
+		}
+
+		if matched {
+			filtered = append(filtered, item)
+		}
+	}
+
+	return filtered
+}
+
+// BySlot matches items equipped in the slot, eg: primary, secondary, melee, misc. Matching ignores case.
+func BySlot(slot string) SchemaItemPredicate {
+	return func(item SchemaItem) bool {
+		return strings.EqualFold(item.ItemSlot, slot)
+	}
+}
+
+// ByClass matches items which list the class, eg: Scout, in their used_by_classes. Matching ignores case.
+// Items without any used_by_classes, such as tools, are not matched.
+func ByClass(class string) SchemaItemPredicate {
+	return func(item SchemaItem) bool {
+		return slices.ContainsFunc(item.UsedByClasses, func(usedBy string) bool {
+			return strings.EqualFold(usedBy, class)
+		})
+	}
+}
+
+// ByQuality matches items with the default item quality.
+func ByQuality(quality int) SchemaItemPredicate {
+	return func(item SchemaItem) bool {
+		return item.ItemQuality == quality
+	}
+}
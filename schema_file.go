@@ -0,0 +1,103 @@
+package steamweb
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+)
+
+// schemaWriteChunk is how much of the schema is written between checks for cancellation.
+const schemaWriteChunk = 64 << 10
+
+// schemaFile is the on disk representation of a Schema.
+type schemaFile struct {
+	Overview *SchemaOverview `json:"overview"`
+	Items    []SchemaItem    `json:"items"`
+}
+
+// WriteSchemaFile saves the schema to path so that it can be loaded with ReadSchemaFile on the next run instead
+// of being fetched again. The file is written atomically, the schema is first written to a temporary file in the
+// same directory which then replaces path. If the context is cancelled or the write fails, the temporary file is
+// removed and any existing file at path is left untouched.
+func WriteSchemaFile(ctx context.Context, path string, schema *Schema) error {
+	data, errMarshal := json.Marshal(schemaFile{Overview: schema.Overview, Items: schema.Items})
+	if errMarshal != nil {
+		return errors.Wrap(errMarshal, "Failed to encode schema")
+	}
+
+	return writeFileAtomic(ctx, path, data)
+}
+
+// ReadSchemaFile loads a schema saved with WriteSchemaFile.
+func ReadSchemaFile(path string) (*Schema, error) {
+	data, errRead := os.ReadFile(path)
+	if errRead != nil {
+		return nil, errors.Wrap(errRead, "Failed to read schema file")
+	}
+
+	var file schemaFile
+	if errUnmarshal := json.Unmarshal(data, &file); errUnmarshal != nil {
+		return nil, errors.Wrap(errUnmarshal, "Failed to decode schema file")
+	}
+
+	if file.Overview == nil {
+		return nil, errors.Wrap(ErrInvalidResponse, "Schema file has no overview")
+	}
+
+	return newSchema(file.Overview, file.Items), nil
+}
+
+// writeFileAtomic writes data to a temporary file next to path, checking for cancellation between chunks, then
+// renames it over path once it is completely written and synced.
+func writeFileAtomic(ctx context.Context, path string, data []byte) error {
+	tmpFile, errCreate := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".tmp*")
+	if errCreate != nil {
+		return errors.Wrap(errCreate, "Failed to create temporary file")
+	}
+
+	committed := false
+
+	defer func() {
+		if !committed {
+			_ = tmpFile.Close()
+			_ = os.Remove(tmpFile.Name())
+		}
+	}()
+
+	for len(data) > 0 {
+		if errCtx := ctx.Err(); errCtx != nil {
+			return errors.Wrap(errCtx, "Write cancelled")
+		}
+
+		chunk := data[:min(len(data), schemaWriteChunk)]
+		if _, errWrite := tmpFile.Write(chunk); errWrite != nil {
+			return errors.Wrap(errWrite, "Failed to write temporary file")
+		}
+
+		data = data[len(chunk):]
+	}
+
+	if errSync := tmpFile.Sync(); errSync != nil {
+		return errors.Wrap(errSync, "Failed to sync temporary file")
+	}
+
+	if errClose := tmpFile.Close(); errClose != nil {
+		return errors.Wrap(errClose, "Failed to close temporary file")
+	}
+
+	// A final check so that a cancellation during the sync does not replace the existing file.
+	if errCtx := ctx.Err(); errCtx != nil {
+		return errors.Wrap(errCtx, "Write cancelled")
+	}
+
+	if errRename := os.Rename(tmpFile.Name(), path); errRename != nil {
+		return errors.Wrap(errRename, "Failed to replace file")
+	}
+
+	committed = true
+
+	return nil
+}
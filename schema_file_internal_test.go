@@ -0,0 +1,74 @@
+package steamweb
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// cancelAfterContext reports itself as cancelled once Err has been called more than checks times, allowing a
+// write to be interrupted part way through.
+type cancelAfterContext struct {
+	context.Context
+	checks int32
+	calls  atomic.Int32
+}
+
+func (c *cancelAfterContext) Err() error {
+	if c.calls.Add(1) > c.checks {
+		return context.Canceled
+	}
+
+	return nil
+}
+
+func TestSchemaFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "schema_440.json")
+	schema := newSchema(&SchemaOverview{Status: 1}, []SchemaItem{
+		{DefIndex: 5021, Name: "Decoder Ring"},
+		{DefIndex: 5002, Name: "Refined Metal"},
+	})
+
+	require.NoError(t, WriteSchemaFile(context.Background(), path, schema))
+
+	loaded, errRead := ReadSchemaFile(path)
+	require.NoError(t, errRead)
+	require.Equal(t, 1, loaded.Overview.Status)
+
+	item, found := loaded.Item(5002)
+	require.True(t, found)
+	require.Equal(t, "Refined Metal", item.Name)
+}
+
+func TestSchemaFileInterrupted(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "schema_440.json")
+	original := newSchema(&SchemaOverview{Status: 1}, []SchemaItem{{DefIndex: 1, Name: "Original"}})
+
+	require.NoError(t, WriteSchemaFile(context.Background(), path, original))
+
+	// Large enough to require several chunks, the context is cancelled after the first one is written.
+	large := newSchema(&SchemaOverview{Status: 1}, []SchemaItem{{
+		DefIndex:        2,
+		Name:            "Replacement",
+		ItemDescription: strings.Repeat("x", schemaWriteChunk*4),
+	}})
+	ctx := &cancelAfterContext{Context: context.Background(), checks: 1}
+
+	require.ErrorIs(t, WriteSchemaFile(ctx, path, large), context.Canceled)
+
+	loaded, errRead := ReadSchemaFile(path)
+	require.NoError(t, errRead)
+
+	_, found := loaded.Item(1)
+	require.True(t, found)
+
+	entries, errDir := os.ReadDir(dir)
+	require.NoError(t, errDir)
+	require.Len(t, entries, 1)
+}
@@ -0,0 +1,362 @@
+package steamweb
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"net"
+	"net/netip"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// ServerRegion is a master server region code used to filter GetServerList results.
+type ServerRegion int
+
+// ServerRegion options
+//
+//goland:noinspection ALL
+const (
+	RegionUSEast       ServerRegion = 0
+	RegionUSWest       ServerRegion = 1
+	RegionSouthAmerica ServerRegion = 2
+	RegionEurope       ServerRegion = 3
+	RegionAsia         ServerRegion = 4
+	RegionAustralia    ServerRegion = 5
+	RegionMiddleEast   ServerRegion = 6
+	RegionAfrica       ServerRegion = 7
+	RegionWorld        ServerRegion = 255
+)
+
+// AllServerRegions contains every specific region, excluding RegionWorld.
+var AllServerRegions = []ServerRegion{ //nolint:gochecknoglobals
+	RegionUSEast, RegionUSWest, RegionSouthAmerica, RegionEurope,
+	RegionAsia, RegionAustralia, RegionMiddleEast, RegionAfrica,
+}
+
+const (
+	// serverListConcurrency is kept low since the server list is especially prone to rate limiting.
+	serverListConcurrency = 2
+	// serverListRegionRetries is how many times a single region is retried after being rate limited.
+	serverListRegionRetries = 3
+	serverListRetryDelay    = time.Second * 2
+)
+
+// RegionError is returned by GetServerListByRegion when one or more regions could not be fetched. The servers
+// from the regions that succeeded are still returned alongside it.
+type RegionError struct {
+	Regions map[ServerRegion]error
+}
+
+func (e *RegionError) Error() string {
+	failed := make([]ServerRegion, 0, len(e.Regions))
+	for region := range e.Regions {
+		failed = append(failed, region)
+	}
+
+	slices.Sort(failed)
+
+	parts := make([]string, len(failed))
+
+	for index, region := range failed {
+		parts[index] = fmt.Sprintf("%d: %v", region, e.Regions[region])
+	}
+
+	return fmt.Sprintf("Failed to fetch server regions: %s", strings.Join(parts, ", "))
+}
+
+// GetServerListByRegion fetches the server list separately for each region and merges the results. Splitting
+// broad queries by region keeps each response smaller and reduces how much is lost if steam rate limits a request.
+// A region that is rate limited is retried on its own with backoff. Any regions that still fail are reported
+// via a *RegionError alongside the servers that were fetched. With no regions given, AllServerRegions is used.
+// The filters should not include a region.
+func GetServerListByRegion(ctx context.Context, client HTTPClientHandler, filters map[string]string,
+	regions ...ServerRegion,
+) ([]Server, error) {
+	if len(regions) == 0 {
+		regions = AllServerRegions
+	}
+
+	results, errs := fanOut(ctx, regions, serverListConcurrency, func(ctx context.Context, region ServerRegion) ([]Server, error) {
+		regionFilters := maps.Clone(filters)
+		if regionFilters == nil {
+			regionFilters = map[string]string{}
+		}
+
+		regionFilters["region"] = fmt.Sprintf("%d", region)
+
+		servers, errServers := getServerListRegion(ctx, client, regionFilters)
+		if errServers != nil {
+			return nil, errServers
+		}
+
+		// Guard against the region filter not being honoured so servers are never duplicated across regions.
+		return slices.DeleteFunc(servers, func(server Server) bool {
+			return ServerRegion(server.Region) != region
+		}), nil
+	})
+
+	var servers []Server
+
+	for _, region := range regions {
+		servers = append(servers, results[region]...)
+	}
+
+	if len(errs) > 0 {
+		return servers, &RegionError{Regions: errs}
+	}
+
+	return servers, nil
+}
+
+// getServerListRegion calls GetServerList, retrying when rate limited.
+func getServerListRegion(ctx context.Context, client HTTPClientHandler, filters map[string]string) ([]Server, error) {
+	delay := serverListRetryDelay
+
+	for attempt := 0; ; attempt++ {
+		servers, errServers := GetServerList(ctx, client, filters)
+		if errServers == nil || attempt >= serverListRegionRetries || !errors.Is(errServers, ErrServiceRateLimit) {
+			return servers, errServers
+		}
+
+		timer := time.NewTimer(delay)
+
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+
+			return nil, errServers
+		case <-timer.C:
+		}
+
+		delay *= 2
+	}
+}
+
+// DetailedServer is a server registered at an address, merged with its master server list entry.
+type DetailedServer struct {
+	ServerAtAddress
+	// Details is the master server list entry of the server, containing the name, map and player counts. It is
+	// nil when the server is registered at the address but is not currently listed.
+	Details *Server
+}
+
+// GetServersAtAddressDetailed calls GetServersAtAddress and merges each server with its entry from a
+// GetServerList query filtered to the same address. Servers without a master list entry are returned with a nil
+// Details. The same errors as GetServersAtAddress and GetServerList are returned.
+func GetServersAtAddressDetailed(ctx context.Context, client HTTPClientHandler, ipAddr net.IP) ([]DetailedServer, error) {
+	registered, errRegistered := GetServersAtAddress(ctx, client, ipAddr)
+	if errRegistered != nil {
+		return nil, errRegistered
+	}
+
+	if len(registered) == 0 {
+		return []DetailedServer{}, nil
+	}
+
+	listed, errListed := GetServerList(ctx, client, map[string]string{"gameaddr": ipAddr.String()})
+	if errListed != nil {
+		return nil, errListed
+	}
+
+	byAddr := make(map[string]Server, len(listed))
+	for _, server := range listed {
+		byAddr[server.Addr] = server
+	}
+
+	detailed := make([]DetailedServer, len(registered))
+
+	for index, server := range registered {
+		detailed[index] = DetailedServer{ServerAtAddress: server}
+
+		if details, found := byAddr[server.Addr]; found {
+			detailed[index].Details = &details
+		}
+	}
+
+	return detailed, nil
+}
+
+const defaultServerListInterval = time.Minute
+
+// ServerChange is a server whose details changed between two polls of WatchServerList.
+type ServerChange struct {
+	Previous Server
+	Current  Server
+}
+
+// ServerListUpdate is the difference between two consecutive polls of WatchServerList. Servers are identified
+// by their addr.
+type ServerListUpdate struct {
+	Added   []Server
+	Removed []Server
+	// Changed holds the servers whose player count, bot count or map changed.
+	Changed []ServerChange
+	Time    time.Time
+	// Err is set when the poll failed, the other fields are empty and the next update is relative to the last
+	// successful poll.
+	Err error
+}
+
+// WatchServerList polls GetServerList with the filters every interval, starting immediately, and emits the
+// difference to the previous poll on the returned channel. The first update reports every server as added. Polls
+// without any changes are not emitted, failed polls are. The channel is closed once the context is cancelled or
+// Shutdown is called. Rate limiting is handled by the client as with any other request, an interval <= 0 uses a
+// default of 1 minute. ErrInvalidFilter is returned immediately if the filters are invalid.
+func WatchServerList(ctx context.Context, client HTTPClientHandler, filters map[string]string,
+	interval time.Duration,
+) (<-chan ServerListUpdate, error) {
+	if _, errFilter := BuildServerFilter(filters); errFilter != nil {
+		return nil, errFilter
+	}
+
+	if interval <= 0 {
+		interval = defaultServerListInterval
+	}
+
+	if errAcquire := lifecycle.acquire(); errAcquire != nil {
+		return nil, errAcquire
+	}
+
+	filters = maps.Clone(filters)
+	updates := make(chan ServerListUpdate, 1)
+	ctx, cancel := lifecycle.background(ctx)
+
+	go func() {
+		defer func() {
+			cancel()
+			close(updates)
+			lifecycle.release()
+		}()
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		previous := map[string]Server{}
+
+		for {
+			servers, errServers := GetServerList(ctx, client, filters)
+			if ctx.Err() != nil {
+				return
+			}
+
+			var update ServerListUpdate
+
+			if errServers != nil {
+				update = ServerListUpdate{Err: errServers}
+			} else {
+				var current map[string]Server
+
+				update, current = diffServerList(previous, servers)
+				previous = current
+			}
+
+			update.Time = time.Now()
+
+			if update.Err != nil || len(update.Added) > 0 || len(update.Removed) > 0 || len(update.Changed) > 0 {
+				select {
+				case updates <- update:
+				case <-ctx.Done():
+					return
+				}
+			}
+
+			select {
+			case <-ticker.C:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return updates, nil
+}
+
+// diffServerList compares the servers of a poll to the previous poll, returning the update and the servers of
+// this poll keyed by addr. Servers are reported in the order they are returned by steam, removed servers are
+// sorted by addr.
+func diffServerList(previous map[string]Server, servers []Server) (ServerListUpdate, map[string]Server) {
+	var update ServerListUpdate
+
+	current := make(map[string]Server, len(servers))
+
+	for _, server := range servers {
+		if _, duplicate := current[server.Addr]; duplicate {
+			continue
+		}
+
+		current[server.Addr] = server
+
+		old, found := previous[server.Addr]
+		if !found {
+			update.Added = append(update.Added, server)
+
+			continue
+		}
+
+		if old.Players != server.Players || old.Bots != server.Bots || old.Map != server.Map {
+			update.Changed = append(update.Changed, ServerChange{Previous: old, Current: server})
+		}
+	}
+
+	for addr, server := range previous {
+		if _, found := current[addr]; !found {
+			update.Removed = append(update.Removed, server)
+		}
+	}
+
+	slices.SortFunc(update.Removed, func(a, b Server) int {
+		return strings.Compare(a.Addr, b.Addr)
+	})
+
+	return update, current
+}
+
+// AddrPort parses Addr, which may be an IPv4 or bracketed IPv6 address, into a typed address and port. When steam
+// omits the port from Addr, GamePort is used instead.
+func (s Server) AddrPort() (netip.AddrPort, error) {
+	return serverAddrPort(s.Addr, s.GamePort)
+}
+
+// AddrPort parses Addr, which may be an IPv4 or bracketed IPv6 address, into a typed address and port. When steam
+// omits the port from Addr, GamePort is used instead.
+func (s ServerAtAddress) AddrPort() (netip.AddrPort, error) {
+	return serverAddrPort(s.Addr, s.GamePort)
+}
+
+func serverAddrPort(addr string, gamePort int) (netip.AddrPort, error) {
+	addrPort, errAddr := parseServerAddr(addr)
+	if errAddr != nil {
+		return netip.AddrPort{}, errAddr
+	}
+
+	if addrPort.Port() == 0 && gamePort > 0 && gamePort <= 65535 {
+		addrPort = netip.AddrPortFrom(addrPort.Addr(), uint16(gamePort))
+	}
+
+	return addrPort, nil
+}
+
+// parseServerAddr parses a host:port server address as returned by steam. Both IPv4 and IPv6, with or without
+// brackets, are accepted. A missing port results in a port of 0. IPv4 mapped IPv6 addresses are unmapped so
+// addresses compare equal regardless of form.
+func parseServerAddr(addr string) (netip.AddrPort, error) {
+	addr = strings.TrimSpace(addr)
+
+	if addrPort, errAddrPort := netip.ParseAddrPort(addr); errAddrPort == nil {
+		return netip.AddrPortFrom(addrPort.Addr().Unmap(), addrPort.Port()), nil
+	}
+
+	// No port, either a bare address or a bracketed IPv6 address.
+	host := strings.TrimSuffix(strings.TrimPrefix(addr, "["), "]")
+
+	ipAddr, errIP := netip.ParseAddr(host)
+	if errIP != nil {
+		return netip.AddrPort{}, errors.Wrapf(ErrInvalidServerAddr, "%q", addr)
+	}
+
+	return netip.AddrPortFrom(ipAddr.Unmap(), 0), nil
+}
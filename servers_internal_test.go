@@ -0,0 +1,65 @@
+package steamweb
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffServerList(t *testing.T) {
+	first, current := diffServerList(map[string]Server{}, []Server{
+		{Addr: "192.0.2.1:27015", Players: 10, Map: "pl_upward"},
+		{Addr: "192.0.2.2:27015", Players: 0, Map: "cp_badlands"},
+		{Addr: "192.0.2.3:27015", Players: 5, Map: "koth_viaduct"},
+	})
+	require.Len(t, first.Added, 3)
+	require.Empty(t, first.Removed)
+	require.Empty(t, first.Changed)
+
+	second, _ := diffServerList(current, []Server{
+		{Addr: "192.0.2.1:27015", Players: 12, Map: "pl_upward"},
+		{Addr: "192.0.2.3:27015", Players: 5, Map: "koth_viaduct"},
+		{Addr: "192.0.2.4:27015", Players: 1, Map: "ctf_2fort"},
+	})
+	require.Len(t, second.Added, 1)
+	require.Equal(t, "192.0.2.4:27015", second.Added[0].Addr)
+	require.Len(t, second.Removed, 1)
+	require.Equal(t, "192.0.2.2:27015", second.Removed[0].Addr)
+	require.Len(t, second.Changed, 1)
+	require.Equal(t, 10, second.Changed[0].Previous.Players)
+	require.Equal(t, 12, second.Changed[0].Current.Players)
+}
+
+func TestParseServerAddr(t *testing.T) {
+	for _, tc := range []struct {
+		addr     string
+		expected netip.AddrPort
+	}{
+		{"192.0.2.1:27015", netip.MustParseAddrPort("192.0.2.1:27015")},
+		{"192.0.2.1", netip.MustParseAddrPort("192.0.2.1:0")},
+		{"[2001:db8::1]:27015", netip.MustParseAddrPort("[2001:db8::1]:27015")},
+		{"[2001:db8::1]", netip.MustParseAddrPort("[2001:db8::1]:0")},
+		{"2001:db8::1", netip.MustParseAddrPort("[2001:db8::1]:0")},
+		{"[::ffff:192.0.2.1]:27015", netip.MustParseAddrPort("192.0.2.1:27015")},
+	} {
+		addrPort, err := parseServerAddr(tc.addr)
+		require.NoError(t, err, tc.addr)
+		require.Equal(t, tc.expected, addrPort, tc.addr)
+	}
+
+	for _, addr := range []string{"", "example.com:27015", "192.0.2.1:port", "192.0.2.1:70000"} {
+		_, err := parseServerAddr(addr)
+		require.ErrorIs(t, err, ErrInvalidServerAddr, addr)
+	}
+}
+
+func TestServerAddrPort(t *testing.T) {
+	addrPort, err := Server{Addr: "192.0.2.1:27016", GamePort: 27015}.AddrPort()
+	require.NoError(t, err)
+	require.Equal(t, uint16(27016), addrPort.Port())
+
+	fallback, errFallback := ServerAtAddress{Addr: "[2001:db8::1]", GamePort: 27015}.AddrPort()
+	require.NoError(t, errFallback)
+	require.Equal(t, netip.MustParseAddrPort("[2001:db8::1]:27015"), fallback)
+}
@@ -0,0 +1,207 @@
+package steamweb
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"github.com/leighmacdonald/steamid/v4/steamid"
+	"github.com/pkg/errors"
+)
+
+// storeURL is the base url for the storefront api. Unlike the web api, it does not require a key.
+const storeURL = "https://store.steampowered.com/api/%s"
+
+// storeRequest performs a request against the unofficial storefront api and decodes the json response into target.
+func storeRequest(ctx context.Context, client HTTPClientHandler, path string, values url.Values, target any) error {
+	rawURL := fmt.Sprintf(storeURL, path)
+	if len(values) > 0 {
+		rawURL += "?" + values.Encode()
+	}
+
+	body, errFetch := fetchRaw(ctx, client, rawURL)
+	if errFetch != nil {
+		return errFetch
+	}
+
+	if errUnmarshal := json.Unmarshal(body, target); errUnmarshal != nil {
+		return errors.Wrap(errUnmarshal, "Failed to decode JSON response")
+	}
+
+	return nil
+}
+
+// AppPriceOverview is the current store price of an app. Prices are in the smallest unit of the currency.
+type AppPriceOverview struct {
+	Currency         string `json:"currency"`
+	Initial          int    `json:"initial"`
+	Final            int    `json:"final"`
+	DiscountPercent  int    `json:"discount_percent"`
+	InitialFormatted string `json:"initial_formatted"`
+	FinalFormatted   string `json:"final_formatted"`
+}
+
+// AppPlatforms lists the operating systems an app supports.
+type AppPlatforms struct {
+	Windows bool `json:"windows"`
+	Mac     bool `json:"mac"`
+	Linux   bool `json:"linux"`
+}
+
+// AppCategory is a store category or genre.
+type AppCategory struct {
+	ID          json.Number `json:"id"`
+	Description string      `json:"description"`
+}
+
+// AppReleaseDate is the release date of an app as shown on the store.
+type AppReleaseDate struct {
+	ComingSoon bool   `json:"coming_soon"`
+	Date       string `json:"date"`
+}
+
+// AppDetails contains the store page details of an app.
+type AppDetails struct {
+	Type             string          `json:"type"`
+	Name             string          `json:"name"`
+	SteamAppID       steamid.AppID   `json:"steam_appid"`
+	RequiredAge      json.Number     `json:"required_age"`
+	IsFree           bool            `json:"is_free"`
+	DLC              []steamid.AppID `json:"dlc"`
+	ShortDescription string          `json:"short_description"`
+	HeaderImage      string          `json:"header_image"`
+	Website          string          `json:"website"`
+	Developers       []string        `json:"developers"`
+	Publishers       []string        `json:"publishers"`
+	// PriceOverview is nil for free apps.
+	PriceOverview *AppPriceOverview `json:"price_overview"`
+	Platforms     AppPlatforms      `json:"platforms"`
+	Categories    []AppCategory     `json:"categories"`
+	Genres        []AppCategory     `json:"genres"`
+	ReleaseDate   AppReleaseDate    `json:"release_date"`
+}
+
+// GetAppDetails fetches the store page details for an app using the storefront api. Text is returned using the
+// language set with WithLang, or SetLang when not set on the context. ErrInvalidResponse is returned for apps
+// without a store page.
+func GetAppDetails(ctx context.Context, client HTTPClientHandler, appID steamid.AppID) (*AppDetails, error) {
+	type appResponse struct {
+		Success bool       `json:"success"`
+		Data    AppDetails `json:"data"`
+	}
+
+	var resp map[string]appResponse
+
+	errResp := storeRequest(ctx, client, "appdetails", url.Values{
+		"appids": []string{fmt.Sprintf("%d", appID)},
+		"l":      []string{langFrom(ctx)},
+	}, &resp)
+	if errResp != nil {
+		return nil, errResp
+	}
+
+	details, found := resp[fmt.Sprintf("%d", appID)]
+	if !found || !details.Success {
+		return nil, errors.Wrapf(ErrInvalidResponse, "No store details for app %d", appID)
+	}
+
+	return &details.Data, nil
+}
+
+// GetAppDLC returns the DLC available for a base game with names resolved from the cached GetAppList results.
+// DLC missing from the app list are returned without a name. Games without any DLC return an empty slice.
+func GetAppDLC(ctx context.Context, client HTTPClientHandler, appID steamid.AppID) ([]App, error) {
+	details, errDetails := GetAppDetails(ctx, client, appID)
+	if errDetails != nil {
+		return nil, errDetails
+	}
+
+	dlc := make([]App, 0, len(details.DLC))
+	if len(details.DLC) == 0 {
+		return dlc, nil
+	}
+
+	apps, errApps := getAppIndex(ctx, client)
+	if errApps != nil {
+		return nil, errApps
+	}
+
+	for _, dlcID := range details.DLC {
+		app, found := apps.find(dlcID)
+		if !found {
+			app = App{AppID: int(dlcID)}
+		}
+
+		dlc = append(dlc, app)
+	}
+
+	return dlc, nil
+}
+
+// FeaturedApp is an app promoted on the store front page. Prices are in the smallest unit of the currency.
+type FeaturedApp struct {
+	ID                 steamid.AppID `json:"id"`
+	Type               int           `json:"type"`
+	Name               string        `json:"name"`
+	Discounted         bool          `json:"discounted"`
+	DiscountPercent    int           `json:"discount_percent"`
+	OriginalPrice      int           `json:"original_price"`
+	FinalPrice         int           `json:"final_price"`
+	Currency           string        `json:"currency"`
+	LargeCapsuleImage  string        `json:"large_capsule_image"`
+	SmallCapsuleImage  string        `json:"small_capsule_image"`
+	HeaderImage        string        `json:"header_image"`
+	WindowsAvailable   bool          `json:"windows_available"`
+	MacAvailable       bool          `json:"mac_available"`
+	LinuxAvailable     bool          `json:"linux_available"`
+	ControllerSupport  string        `json:"controller_support"`
+	DiscountExpiration int64         `json:"discount_expiration"`
+}
+
+// FeaturedApps contains the apps shown on the store front page.
+type FeaturedApps struct {
+	LargeCapsules []FeaturedApp `json:"large_capsules"`
+	FeaturedWin   []FeaturedApp `json:"featured_win"`
+	FeaturedMac   []FeaturedApp `json:"featured_mac"`
+	FeaturedLinux []FeaturedApp `json:"featured_linux"`
+	Layout        string        `json:"layout"`
+	Status        int           `json:"status"`
+}
+
+// FeaturedOptions controls the region and language of GetFeatured results.
+type FeaturedOptions struct {
+	// CountryCode is the ISO 3166-1 alpha 2 country code used for pricing, eg: US.
+	CountryCode string
+	// Language overrides the language set with WithLang or SetLang.
+	Language string
+}
+
+// GetFeatured fetches the apps currently featured on the store front page using the storefront api.
+// A nil opts uses the steam default region and the language set with WithLang, or SetLang when not set on the
+// context.
+func GetFeatured(ctx context.Context, client HTTPClientHandler, opts *FeaturedOptions) (*FeaturedApps, error) {
+	values := url.Values{"l": []string{langFrom(ctx)}}
+
+	if opts != nil {
+		if opts.CountryCode != "" {
+			values.Set("cc", opts.CountryCode)
+		}
+
+		if opts.Language != "" {
+			values.Set("l", opts.Language)
+		}
+	}
+
+	var featured FeaturedApps
+
+	if errResp := storeRequest(ctx, client, "featured", values, &featured); errResp != nil {
+		return nil, errResp
+	}
+
+	if featured.Status != 1 {
+		return nil, ErrInvalidResponse
+	}
+
+	return &featured, nil
+}
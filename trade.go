@@ -0,0 +1,138 @@
+package steamweb
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+
+	"github.com/leighmacdonald/steamid/v4/steamid"
+	"github.com/pkg/errors"
+)
+
+// ErrTradeOfferNotFound is returned by GetTradeOffer when the offer does not exist or does not belong to the
+// owner of the api key.
+var ErrTradeOfferNotFound = errors.New("Trade offer not found")
+
+// TradeOfferState is the state of a trade offer.
+type TradeOfferState int
+
+// TradeOfferState options
+//
+//goland:noinspection ALL
+const (
+	TradeOfferStateInvalid TradeOfferState = iota + 1
+	TradeOfferStateActive
+	TradeOfferStateAccepted
+	TradeOfferStateCountered
+	TradeOfferStateExpired
+	TradeOfferStateCanceled
+	TradeOfferStateDeclined
+	TradeOfferStateInvalidItems
+	TradeOfferStateCreatedNeedsConfirmation
+	TradeOfferStateCanceledBySecondFactor
+	TradeOfferStateInEscrow
+)
+
+// TradeAsset is an item included in one side of a trade offer.
+type TradeAsset struct {
+	AppID      steamid.AppID `json:"appid"`
+	ContextID  string        `json:"contextid"`
+	AssetID    string        `json:"assetid"`
+	ClassID    string        `json:"classid"`
+	InstanceID string        `json:"instanceid"`
+	Amount     string        `json:"amount"`
+	// Missing is true when the item is no longer in the inventory it was offered from.
+	Missing bool `json:"missing"`
+}
+
+// TradeItemDescription describes a class of item included in a trade offer.
+type TradeItemDescription struct {
+	AppID           steamid.AppID      `json:"appid"`
+	ClassID         string             `json:"classid"`
+	InstanceID      string             `json:"instanceid"`
+	Currency        bool               `json:"currency"`
+	BackgroundColor string             `json:"background_color"`
+	IconURL         string             `json:"icon_url"`
+	IconURLLarge    string             `json:"icon_url_large"`
+	Descriptions    []AssetDescription `json:"descriptions"`
+	Tradable        bool               `json:"tradable"`
+	Actions         []AssetAction      `json:"actions"`
+	Name            string             `json:"name"`
+	NameColor       string             `json:"name_color"`
+	Type            string             `json:"type"`
+	MarketName      string             `json:"market_name"`
+	MarketHashName  string             `json:"market_hash_name"`
+	Commodity       bool               `json:"commodity"`
+	Marketable      bool               `json:"marketable"`
+}
+
+// TradeOffer is a single trade offer returned by GetTradeOffer.
+type TradeOffer struct {
+	TradeOfferID string `json:"tradeofferid"`
+	// AccountIDOther is the 32bit account id of the other party.
+	AccountIDOther     uint32          `json:"accountid_other"`
+	Message            string          `json:"message"`
+	ExpirationTime     int64           `json:"expiration_time"`
+	TradeOfferState    TradeOfferState `json:"trade_offer_state"`
+	ItemsToGive        []TradeAsset    `json:"items_to_give"`
+	ItemsToReceive     []TradeAsset    `json:"items_to_receive"`
+	IsOurOffer         bool            `json:"is_our_offer"`
+	TimeCreated        int64           `json:"time_created"`
+	TimeUpdated        int64           `json:"time_updated"`
+	FromRealTimeTrade  bool            `json:"from_real_time_trade"`
+	EscrowEndDate      int64           `json:"escrow_end_date"`
+	ConfirmationMethod int             `json:"confirmation_method"`
+	// Descriptions holds the descriptions of the offered items keyed by classid_instanceid. It is only
+	// populated when requested, use Description to look up the description of an asset.
+	Descriptions map[string]TradeItemDescription `json:"descriptions,omitempty"`
+}
+
+// Description returns the description of an asset included in the offer.
+func (o *TradeOffer) Description(asset TradeAsset) (TradeItemDescription, bool) {
+	description, found := o.Descriptions[tradeDescriptionKey(asset.ClassID, asset.InstanceID)]
+
+	return description, found
+}
+
+func tradeDescriptionKey(classID string, instanceID string) string {
+	return classID + "_" + instanceID
+}
+
+// GetTradeOffer fetches a single trade offer belonging to the owner of the api key. When getDescriptions is true,
+// the descriptions of the offered items are included using the language set with WithLang, or SetLang when not
+// set on the context. ErrTradeOfferNotFound is returned when the offer does not exist or belongs to someone else.
+func GetTradeOffer(ctx context.Context, client HTTPClientHandler, tradeOfferID uint64, getDescriptions bool) (*TradeOffer, error) {
+	type response struct {
+		Response struct {
+			Offer        *TradeOffer            `json:"offer"`
+			Descriptions []TradeItemDescription `json:"descriptions"`
+		} `json:"response"`
+	}
+
+	var resp response
+
+	errResp := apiRequest(ctx, client, "/IEconService/GetTradeOffer/v1", url.Values{
+		"tradeofferid":     []string{strconv.FormatUint(tradeOfferID, 10)},
+		"get_descriptions": []string{strconv.FormatBool(getDescriptions)},
+		"language":         []string{langFrom(ctx)},
+	}, &resp)
+	if errResp != nil {
+		return nil, errResp
+	}
+
+	// Steam responds with an empty response for offers that do not exist or that belong to another account.
+	offer := resp.Response.Offer
+	if offer == nil || offer.TradeOfferID == "" {
+		return nil, errors.Wrapf(ErrTradeOfferNotFound, "%d", tradeOfferID)
+	}
+
+	if len(resp.Response.Descriptions) > 0 {
+		offer.Descriptions = make(map[string]TradeItemDescription, len(resp.Response.Descriptions))
+
+		for _, description := range resp.Response.Descriptions {
+			offer.Descriptions[tradeDescriptionKey(description.ClassID, description.InstanceID)] = description
+		}
+	}
+
+	return offer, nil
+}
@@ -0,0 +1,183 @@
+package steamweb
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	// ErrUnknownMethod is returned by ValidateCallParams when the interface method is not in the supported api list.
+	ErrUnknownMethod = errors.New("Unknown api method")
+	// ErrInvalidParams is matched by a *ParamsError with errors.Is.
+	ErrInvalidParams = errors.New("Invalid api parameters")
+)
+
+// implicitParams are added to every request by the package and never need to be provided.
+var implicitParams = map[string]bool{"key": true, "format": true} //nolint:gochecknoglobals
+
+// arrayParamSuffix matches the index suffix used for array parameters, eg: appids_filter[0].
+var arrayParamSuffix = regexp.MustCompile(`\[\d+]$`) //nolint:gochecknoglobals
+
+// ParamsError describes every problem ValidateCallParams found with the parameters of a call.
+type ParamsError struct {
+	Interface string
+	Method    string
+	// Missing holds required parameters that were not provided.
+	Missing []string
+	// Unknown holds provided parameters that the method does not accept.
+	Unknown []string
+	// Invalid holds provided parameters whose value does not match the parameter type, keyed by name.
+	Invalid map[string]SupportedAPIParameterType
+}
+
+func (e *ParamsError) Error() string {
+	var problems []string
+
+	if len(e.Missing) > 0 {
+		problems = append(problems, "missing: "+strings.Join(e.Missing, ", "))
+	}
+
+	if len(e.Unknown) > 0 {
+		problems = append(problems, "unknown: "+strings.Join(e.Unknown, ", "))
+	}
+
+	if len(e.Invalid) > 0 {
+		names := make([]string, 0, len(e.Invalid))
+		for name := range e.Invalid {
+			names = append(names, name)
+		}
+
+		sort.Strings(names)
+
+		for index, name := range names {
+			names[index] = fmt.Sprintf("%s (%s)", name, e.Invalid[name])
+		}
+
+		problems = append(problems, "invalid: "+strings.Join(names, ", "))
+	}
+
+	return fmt.Sprintf("Invalid parameters for %s/%s: %s", e.Interface, e.Method, strings.Join(problems, "; "))
+}
+
+func (e *ParamsError) Is(target error) bool {
+	return target == ErrInvalidParams //nolint:errorlint
+}
+
+// ValidateCallParams checks params against the parameter definitions of the interface method from the cached
+// GetSupportedAPIList results, eg: ISteamUser, GetPlayerSummaries. The definitions of the highest available
+// version of the method are used. Missing required parameters, unknown parameters and values that are not valid
+// for uint32 and uint64 parameters are all reported together in a *ParamsError. The key and format parameters
+// are added by the package and are ignored. Indexed array parameters, eg: appids_filter[0], are matched by their
+// base name. ErrUnknownMethod is returned when the method is not listed.
+func ValidateCallParams(ctx context.Context, client HTTPClientHandler, iface string, method string, params url.Values) error {
+	interfaces, errList := GetSupportedAPIList(ctx, client)
+	if errList != nil {
+		return errList
+	}
+
+	apiMethod, found := findAPIMethod(interfaces, iface, method)
+	if !found {
+		return errors.Wrapf(ErrUnknownMethod, "%s/%s", iface, method)
+	}
+
+	return validateParams(iface, apiMethod, params)
+}
+
+// findAPIMethod returns the highest version of the interface method, ignoring case.
+func findAPIMethod(interfaces []SupportedAPIInterfaces, iface string, method string) (SupportedAPIMethods, bool) {
+	apiInterface, found := findAPIInterface(interfaces, iface)
+	if !found {
+		return SupportedAPIMethods{}, false
+	}
+
+	var latest *SupportedAPIMethods
+
+	for index, apiMethod := range apiInterface.Methods {
+		if strings.EqualFold(apiMethod.Name, method) && (latest == nil || apiMethod.Version > latest.Version) {
+			latest = &apiInterface.Methods[index]
+		}
+	}
+
+	if latest == nil {
+		return SupportedAPIMethods{}, false
+	}
+
+	return *latest, true
+}
+
+func validateParams(iface string, method SupportedAPIMethods, params url.Values) error {
+	known := make(map[string]SupportedAPIParameter, len(method.Parameters))
+	for _, param := range method.Parameters {
+		known[arrayParamSuffix.ReplaceAllString(param.Name, "")] = param
+	}
+
+	paramsErr := &ParamsError{Interface: iface, Method: method.Name, Invalid: map[string]SupportedAPIParameterType{}}
+	provided := map[string]bool{}
+
+	names := make([]string, 0, len(params))
+	for name := range params {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	for _, name := range names {
+		baseName := arrayParamSuffix.ReplaceAllString(name, "")
+		provided[baseName] = true
+
+		if implicitParams[baseName] {
+			continue
+		}
+
+		param, found := known[baseName]
+		if !found {
+			paramsErr.Unknown = append(paramsErr.Unknown, name)
+
+			continue
+		}
+
+		for _, value := range params[name] {
+			if !validParamValue(param.Type, value) {
+				paramsErr.Invalid[name] = param.Type
+
+				break
+			}
+		}
+	}
+
+	for _, param := range method.Parameters {
+		baseName := arrayParamSuffix.ReplaceAllString(param.Name, "")
+		if !param.Optional && !implicitParams[baseName] && !provided[baseName] {
+			paramsErr.Missing = append(paramsErr.Missing, param.Name)
+		}
+	}
+
+	if len(paramsErr.Missing) == 0 && len(paramsErr.Unknown) == 0 && len(paramsErr.Invalid) == 0 {
+		return nil
+	}
+
+	return paramsErr
+}
+
+func validParamValue(paramType SupportedAPIParameterType, value string) bool {
+	switch paramType {
+	case PTUint32:
+		_, errParse := strconv.ParseUint(value, 10, 32)
+
+		return errParse == nil
+	case PTUint64:
+		_, errParse := strconv.ParseUint(value, 10, 64)
+
+		return errParse == nil
+	case PTString:
+	}
+
+	return true
+}
@@ -0,0 +1,57 @@
+package steamweb
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateParams(t *testing.T) {
+	interfaces := []SupportedAPIInterfaces{{
+		Name: "IPlayerService",
+		Methods: []SupportedAPIMethods{
+			{Name: "GetOwnedGames", Version: 1, Parameters: []SupportedAPIParameter{
+				{Name: "key", Type: PTString},
+				{Name: "steamid", Type: PTUint64},
+			}},
+			{Name: "GetOwnedGames", Version: 2, Parameters: []SupportedAPIParameter{
+				{Name: "key", Type: PTString},
+				{Name: "steamid", Type: PTUint64},
+				{Name: "include_appinfo", Type: "bool", Optional: true},
+				{Name: "appids_filter", Type: "{uint32}", Optional: true},
+				{Name: "count", Type: PTUint32, Optional: true},
+			}},
+		},
+	}}
+
+	method, found := findAPIMethod(interfaces, "iplayerservice", "getownedgames")
+	require.True(t, found)
+	require.Equal(t, 2, method.Version)
+
+	_, found = findAPIMethod(interfaces, "IPlayerService", "GetBadges")
+	require.False(t, found)
+
+	require.NoError(t, validateParams("IPlayerService", method, url.Values{
+		"steamid":          []string{"76561197960287930"},
+		"include_appinfo":  []string{"true"},
+		"appids_filter[0]": []string{"440"},
+		"key":              []string{"secret"},
+	}))
+
+	errParams := validateParams("IPlayerService", method, url.Values{
+		"count":   []string{"-1"},
+		"unknown": []string{"1"},
+	})
+	require.ErrorIs(t, errParams, ErrInvalidParams)
+
+	var paramsErr *ParamsError
+
+	require.True(t, errors.As(errParams, &paramsErr))
+	require.Equal(t, []string{"steamid"}, paramsErr.Missing)
+	require.Equal(t, []string{"unknown"}, paramsErr.Unknown)
+	require.Equal(t, map[string]SupportedAPIParameterType{"count": PTUint32}, paramsErr.Invalid)
+	require.Equal(t, "Invalid parameters for IPlayerService/GetOwnedGames: missing: steamid; unknown: unknown; "+
+		"invalid: count (uint32)", paramsErr.Error())
+}
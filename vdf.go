@@ -0,0 +1,260 @@
+package steamweb
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"net/url"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// ErrInvalidVDF is returned by ParseVDF for malformed input.
+var ErrInvalidVDF = errors.New("Invalid VDF")
+
+// VDF is a parsed Valve Data Format (KeyValues) document. Values are either a string or a nested VDF.
+type VDF map[string]any
+
+// String returns the string value of the key.
+func (v VDF) String(key string) (string, bool) {
+	value, ok := v[key].(string)
+
+	return value, ok
+}
+
+// Child returns the nested VDF of the key.
+func (v VDF) Child(key string) (VDF, bool) {
+	value, ok := v[key].(VDF)
+
+	return value, ok
+}
+
+// ParseVDF parses a text VDF document, such as items_game.txt or an api response requested with format=vdf.
+// Quoted and unquoted tokens, escape sequences, // comments and platform conditionals, eg: [$WIN32], are
+// supported, conditionals are ignored. When a key is repeated within the same section, nested sections are
+// merged and otherwise the last value wins.
+func ParseVDF(reader io.Reader) (VDF, error) {
+	lexer := &vdfLexer{reader: bufio.NewReader(reader)}
+
+	root, errParse := lexer.parseSection(false)
+	if errParse != nil {
+		return nil, errParse
+	}
+
+	return root, nil
+}
+
+type vdfLexer struct {
+	reader *bufio.Reader
+	line   int
+}
+
+// vdfToken is a single token, open and close are set for braces, otherwise value holds the token text.
+type vdfToken struct {
+	value string
+	open  bool
+	close bool
+}
+
+func (l *vdfLexer) errorf(format string, args ...any) error {
+	return errors.Wrapf(ErrInvalidVDF, "line %d: "+format, append([]any{l.line + 1}, args...)...)
+}
+
+// parseSection reads key value pairs until the closing brace, or the end of input for the root section.
+func (l *vdfLexer) parseSection(nested bool) (VDF, error) {
+	section := VDF{}
+
+	for {
+		key, errKey := l.next()
+		if errors.Is(errKey, io.EOF) {
+			if nested {
+				return nil, l.errorf("unexpected end of input")
+			}
+
+			return section, nil
+		}
+
+		if errKey != nil {
+			return nil, errKey
+		}
+
+		if key.close {
+			if !nested {
+				return nil, l.errorf("unexpected }")
+			}
+
+			return section, nil
+		}
+
+		if key.open {
+			return nil, l.errorf("unexpected {")
+		}
+
+		value, errValue := l.next()
+		if errValue != nil {
+			if errors.Is(errValue, io.EOF) {
+				return nil, l.errorf("missing value for %q", key.value)
+			}
+
+			return nil, errValue
+		}
+
+		switch {
+		case value.open:
+			child, errChild := l.parseSection(true)
+			if errChild != nil {
+				return nil, errChild
+			}
+
+			if existing, ok := section[key.value].(VDF); ok {
+				for childKey, childValue := range child {
+					existing[childKey] = childValue
+				}
+			} else {
+				section[key.value] = child
+			}
+		case value.close:
+			return nil, l.errorf("missing value for %q", key.value)
+		default:
+			section[key.value] = value.value
+		}
+	}
+}
+
+// next returns the next token, skipping whitespace, comments and conditionals.
+func (l *vdfLexer) next() (vdfToken, error) {
+	for {
+		char, errRead := l.reader.ReadByte()
+		if errRead != nil {
+			return vdfToken{}, errRead //nolint:wrapcheck
+		}
+
+		switch char {
+		case '\n':
+			l.line++
+		case ' ', '\t', '\r':
+		case '{':
+			return vdfToken{open: true}, nil
+		case '}':
+			return vdfToken{close: true}, nil
+		case '"':
+			return l.quoted()
+		case '[':
+			if _, errSkip := l.reader.ReadString(']'); errSkip != nil {
+				return vdfToken{}, l.errorf("unterminated conditional")
+			}
+		case '/':
+			if peek, _ := l.reader.Peek(1); len(peek) == 1 && peek[0] == '/' {
+				if _, errSkip := l.reader.ReadString('\n'); errSkip != nil && !errors.Is(errSkip, io.EOF) {
+					return vdfToken{}, errors.Wrap(errSkip, "Failed to read comment")
+				}
+
+				l.line++
+
+				continue
+			}
+
+			return l.unquoted(char)
+		default:
+			return l.unquoted(char)
+		}
+	}
+}
+
+func (l *vdfLexer) quoted() (vdfToken, error) {
+	var builder strings.Builder
+
+	for {
+		char, errRead := l.reader.ReadByte()
+		if errRead != nil {
+			return vdfToken{}, l.errorf("unterminated string")
+		}
+
+		switch char {
+		case '"':
+			return vdfToken{value: builder.String()}, nil
+		case '\\':
+			escaped, errEscaped := l.reader.ReadByte()
+			if errEscaped != nil {
+				return vdfToken{}, l.errorf("unterminated string")
+			}
+
+			switch escaped {
+			case 'n':
+				builder.WriteByte('\n')
+			case 't':
+				builder.WriteByte('\t')
+			default:
+				builder.WriteByte(escaped)
+			}
+		case '\n':
+			l.line++
+
+			builder.WriteByte(char)
+		default:
+			builder.WriteByte(char)
+		}
+	}
+}
+
+func (l *vdfLexer) unquoted(first byte) (vdfToken, error) {
+	var builder strings.Builder
+
+	builder.WriteByte(first)
+
+	for {
+		char, errRead := l.reader.ReadByte()
+		if errRead != nil {
+			if errors.Is(errRead, io.EOF) {
+				return vdfToken{value: builder.String()}, nil
+			}
+
+			return vdfToken{}, errors.Wrap(errRead, "Failed to read token")
+		}
+
+		switch char {
+		case ' ', '\t', '\r', '\n', '{', '}', '"':
+			_ = l.reader.UnreadByte()
+
+			return vdfToken{value: builder.String()}, nil
+		default:
+			builder.WriteByte(char)
+		}
+	}
+}
+
+// vdfResponse is a request target which asks for, and decodes, a format=vdf response.
+type vdfResponse struct {
+	result VDF
+}
+
+func (r *vdfResponse) responseFormat() string {
+	return "vdf"
+}
+
+func (r *vdfResponse) decodeResponse(body io.Reader) error {
+	result, errParse := ParseVDF(body)
+	if errParse != nil {
+		return errParse
+	}
+
+	r.result = result
+
+	return nil
+}
+
+// apiRequestVDF performs an api request using format=vdf, for endpoints where the vdf output is richer than json.
+func apiRequestVDF(ctx context.Context, client HTTPClientHandler, path string, values url.Values) (VDF, error) {
+	if values == nil {
+		values = url.Values{}
+	}
+
+	var resp vdfResponse
+
+	if errResp := apiRequest(ctx, client, path, values, &resp); errResp != nil {
+		return nil, errResp
+	}
+
+	return resp.result, nil
+}
@@ -0,0 +1,73 @@
+package steamweb
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseVDF(t *testing.T) {
+	const doc = `// items_game.txt
+"items_game"
+{
+	"game_info"
+	{
+		"first_valid_class"	"1"
+		unquoted_key unquoted_value
+	}
+	"items"
+	{
+		"5021"
+		{
+			"name"	"Decoder Ring"
+			"desc"	"line \"one\"\nline two" [$WIN32]
+		}
+	}
+	"items"
+	{
+		"5002" { "name" "Refined Metal" }
+	}
+}`
+
+	root, err := ParseVDF(strings.NewReader(doc))
+	require.NoError(t, err)
+
+	itemsGame, found := root.Child("items_game")
+	require.True(t, found)
+
+	gameInfo, _ := itemsGame.Child("game_info")
+	first, _ := gameInfo.String("first_valid_class")
+	require.Equal(t, "1", first)
+
+	unquoted, _ := gameInfo.String("unquoted_key")
+	require.Equal(t, "unquoted_value", unquoted)
+
+	items, _ := itemsGame.Child("items")
+	require.Len(t, items, 2)
+
+	ring, _ := items.Child("5021")
+	desc, _ := ring.String("desc")
+	require.Equal(t, "line \"one\"\nline two", desc)
+
+	for _, invalid := range []string{`"a" {`, `"a" }`, `"a"`, `"a`, `}`, `{`} {
+		_, errInvalid := ParseVDF(strings.NewReader(invalid))
+		require.ErrorIs(t, errInvalid, ErrInvalidVDF, invalid)
+	}
+}
+
+func TestAPIRequestVDF(t *testing.T) {
+	client := NewMockClient(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "vdf", r.URL.Query().Get("format"))
+		_, _ = w.Write([]byte(`"response" { "player_count" "1234" "result" "1" }`))
+	}))
+
+	result, err := apiRequestVDF(context.Background(), client, "/ISteamUserStats/GetNumberOfCurrentPlayers/v1", nil)
+	require.NoError(t, err)
+
+	response, _ := result.Child("response")
+	count, _ := response.String("player_count")
+	require.Equal(t, "1234", count)
+}
@@ -6,12 +6,17 @@
 // A key can be set using steam_webapi.SetKey or using the environment variable STEAM_TOKEN
 //
 // Some results are cached due to being static content that does not need to be updated frequently. These include:
-// GetAppList, GetStoreMetaData, GetSchemaURL, GetSchemaOverview, GetSchemaItems, GetSupportedAPIList
+// GetAppList, GetStoreMetaData, GetSchemaURL, GetSchemaOverview, GetSchemaItems, GetSupportedAPIList,
+// GetGameStatsSchema, GetProfileItemsEquipped, GetAssetClassInfo, GetOwnedGames, GetPublishedFileDetails. Use
+// WithCacheBypass to skip the cache for a request.
 package steamweb
 
 import (
+	"bufio"
+	"bytes"
 	"context"
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 	"io"
 	"log"
@@ -19,7 +24,9 @@ import (
 	"net/http"
 	"net/url"
 	"os"
-	"regexp"
+	"reflect"
+	"slices"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
@@ -28,6 +35,7 @@ import (
 	"github.com/leighmacdonald/steamid/v4/steamid"
 	"github.com/mitchellh/mapstructure"
 	"github.com/pkg/errors"
+	"golang.org/x/time/rate"
 )
 
 const (
@@ -36,25 +44,145 @@ const (
 	maxSteamIDsPerRequest = 100
 )
 
+// HTTPClientHandler performs the HTTP requests for the package. Rate limiting, if desired, should be implemented
+// by the handler. Every function accepts a nil handler, in which case the client set with SetDefaultClient is used.
 type HTTPClientHandler interface {
 	Do(req *http.Request) (*http.Response, error)
 }
 
 var (
-	// ErrInvalidResponse is Returned when a non 200 response occurs.
+	// ErrInvalidResponse is Returned when steam responds successfully, but indicates failure in the response.
 	ErrInvalidResponse = errors.New("Invalid response")
 	// ErrServiceUnavailable is returned when the steam api is down / not available for some reason / it's tuesday.
 	ErrServiceUnavailable = errors.New("Service Unavailable")
 	ErrServiceRateLimit   = errors.New("Rate limited")
+	// ErrUnauthorized is returned when steam responds with a 401. This usually means the key is invalid or
+	// the requested resource is not public.
+	ErrUnauthorized = errors.New("Unauthorized")
+	// ErrProfilePrivate is returned when the requested profile data is not publicly visible.
+	ErrProfilePrivate = errors.New("Profile is private")
+	// ErrIPv6Unsupported is returned when an IPv6 address is passed to an endpoint that only supports IPv4.
+	ErrIPv6Unsupported = errors.New("IPv6 addresses are not supported")
+	// ErrTooFewIDs is returned when a function requiring at least one steam id is passed none.
+	ErrTooFewIDs = errors.New("Too few steam ids, min 1")
+	// ErrTooManyIDs is returned when more than 100 steam ids are passed to a function that only accepts 100
+	// per request. The batch helpers, such as GetPlayerBansMap, split larger collections automatically.
+	ErrTooManyIDs = errors.New("Too many steam ids, max 100")
+	// ErrInvalidFilter is returned when a server filter key or value contains a character that cannot be
+	// represented in the filter syntax.
+	ErrInvalidFilter = errors.New("Invalid server filter")
+	// ErrInvalidServerAddr is returned by Server.AddrPort and ServerAtAddress.AddrPort when the address cannot be parsed.
+	ErrInvalidServerAddr = errors.New("Invalid server address")
+	// ErrGameNotOwned is returned when the user does not own the requested game.
+	ErrGameNotOwned = errors.New("Game not owned")
 	// ErrNoAPIKey is returned for functions that require an API key to use when one has not been set.
 	ErrNoAPIKey = errors.New("No steam web api key, to obtain one see: " +
 		"https://steamcommunity.com/dev/apikey and call SetKey()")
-	apiKey = ""         //nolint:gochecknoglobals
-	lang   = "en_US"    //nolint:gochecknoglobals
-	cfgMu  sync.RWMutex //nolint:gochecknoglobals
-
+	// ErrUnknownAppID is returned when validation is enabled with WithValidateAppID and the app id does not exist.
+	ErrUnknownAppID = errors.New("Unknown app id")
+	// ErrEmptyResponse is returned when steam responds successfully, but with an empty body, which happens
+	// intermittently during partial outages. It is considered retryable by IsRetryable.
+	ErrEmptyResponse = errors.New("Empty response body")
+	// ErrResponseTooLarge is returned when a response body exceeds the limit set with SetMaxResponseBytes.
+	ErrResponseTooLarge = errors.New("Response body too large")
+	// ErrVanityNotFound is returned by ResolveVanityURLs for names that do not belong to any profile.
+	ErrVanityNotFound = errors.New("Vanity name not found")
+	// ErrInterfaceNotFound is returned by GetSupportedAPIInterface when the interface is not in the supported api list.
+	ErrInterfaceNotFound = errors.New("Interface not found")
+	apiKey               = ""         //nolint:gochecknoglobals
+	lang                 = "en_US"    //nolint:gochecknoglobals
+	cfgMu                sync.RWMutex //nolint:gochecknoglobals
+	// baseCtx is a parent for all requests, cancelling it aborts any in-flight and future requests.
+	baseCtx = context.Background() //nolint:gochecknoglobals
+	// defaultClient is used for requests when a nil HTTPClientHandler is passed to a function.
+	defaultClient HTTPClientHandler = &http.Client{} //nolint:gochecknoglobals
+	// endpointTimeouts holds per-endpoint overrides of defaultRequestTimeout keyed by normalized path.
+	endpointTimeouts = map[string]time.Duration{} //nolint:gochecknoglobals
+	// maxResponseBytes caps the size of a single response body, see SetMaxResponseBytes.
+	maxResponseBytes int64 = defaultMaxResponseBytes //nolint:gochecknoglobals
+	// strictDecode rejects responses containing fields not modelled by the target, see SetStrictDecode.
+	strictDecode = false //nolint:gochecknoglobals
 )
 
+// defaultMaxResponseBytes leaves ample headroom over the largest known responses, the ~10MB app list and the
+// full TF2 item schema.
+const defaultMaxResponseBytes = 128 << 20
+
+// SetMaxResponseBytes sets the maximum size of a response body that will be read. Larger responses are abandoned
+// once the limit is reached and ErrResponseTooLarge is returned, guarding against unbounded memory use from a
+// misbehaving server or proxy. A value <= 0 restores the default of 128MiB.
+func SetMaxResponseBytes(n int64) {
+	if n <= 0 {
+		n = defaultMaxResponseBytes
+	}
+
+	cfgMu.Lock()
+	maxResponseBytes = n
+	cfgMu.Unlock()
+}
+
+// SetStrictDecode enables or disables strict decoding of api responses. When enabled, any field in a response
+// that is not modelled by the returned types fails the request with a decode error, which is useful for
+// monitoring deployments that want to detect changes to the shape of steam responses early. It is disabled by
+// default as steam frequently adds fields, which would otherwise break normal callers.
+func SetStrictDecode(enabled bool) {
+	cfgMu.Lock()
+	strictDecode = enabled
+	cfgMu.Unlock()
+}
+
+// newResponseDecoder returns a json.Decoder for the body configured according to SetStrictDecode.
+func newResponseDecoder(body io.Reader) *json.Decoder {
+	decoder := json.NewDecoder(body)
+
+	cfgMu.RLock()
+	defer cfgMu.RUnlock()
+
+	if strictDecode {
+		decoder.DisallowUnknownFields()
+	}
+
+	return decoder
+}
+
+// limitedBody is like io.LimitReader, but returns ErrResponseTooLarge instead of io.EOF once more than the
+// limit has been read so that truncated bodies are not mistaken for complete ones.
+type limitedBody struct {
+	reader    io.Reader
+	remaining int64
+}
+
+func newLimitedBody(reader io.Reader) *limitedBody {
+	cfgMu.RLock()
+	defer cfgMu.RUnlock()
+
+	return &limitedBody{reader: reader, remaining: maxResponseBytes}
+}
+
+func (l *limitedBody) Read(buf []byte) (int, error) {
+	if l.remaining < 0 {
+		return 0, ErrResponseTooLarge
+	}
+
+	// Read a single byte past the limit so that a body of exactly the limit is still accepted. The comparison is
+	// written to avoid overflowing when the limit is math.MaxInt64.
+	if int64(len(buf))-1 > l.remaining {
+		buf = buf[:l.remaining+1]
+	}
+
+	read, err := l.reader.Read(buf)
+	if int64(read) > l.remaining {
+		// The extra byte is dropped so that callers never see more than the limit.
+		read, l.remaining = int(l.remaining), -1
+
+		return read, ErrResponseTooLarge
+	}
+
+	l.remaining -= int64(read)
+
+	return read, err //nolint:wrapcheck
+}
+
 func init() {
 	v, found := os.LookupEnv("STEAM_TOKEN")
 	if found && v != "" {
@@ -107,6 +235,130 @@ func SetLang(newLang string) error {
 	return nil
 }
 
+// Lang returns the current package level language set with SetLang. A language set on a context with WithLang
+// takes precedence over it for requests made using that context.
+func Lang() string {
+	cfgMu.RLock()
+	defer cfgMu.RUnlock()
+
+	return lang
+}
+
+// SetBaseContext sets a context that acts as an additional parent for every request. Once it is cancelled,
+// any in-flight and future requests fail with its error. This is useful for aborting all requests on shutdown
+// without having to thread a context through every call site. Passing nil restores the default,
+// context.Background().
+func SetBaseContext(ctx context.Context) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	cfgMu.Lock()
+	baseCtx = ctx
+	cfgMu.Unlock()
+}
+
+// requestContext derives the context for a single request from the callers context, the timeout and the
+// base context. A timeout set with WithTimeout replaces the passed in timeout. Otherwise, when the callers context
+// already has a deadline, that deadline is used instead of the timeout.
+func requestContext(ctx context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
+	cfgMu.RLock()
+	base := baseCtx
+	cfgMu.RUnlock()
+
+	var (
+		reqCtx context.Context
+		cancel context.CancelFunc
+	)
+
+	if ctxTimeout, found := timeoutFrom(ctx); found {
+		reqCtx, cancel = context.WithTimeout(ctx, ctxTimeout)
+	} else if _, hasDeadline := ctx.Deadline(); hasDeadline {
+		reqCtx, cancel = context.WithCancel(ctx)
+	} else {
+		reqCtx, cancel = context.WithTimeout(ctx, timeout)
+	}
+
+	if base.Done() == nil {
+		return reqCtx, cancel
+	}
+
+	if base.Err() != nil {
+		cancel()
+
+		return reqCtx, cancel
+	}
+
+	stop := context.AfterFunc(base, cancel)
+
+	return reqCtx, func() {
+		stop()
+		cancel()
+	}
+}
+
+// SetDefaultClient sets the package level HTTPClientHandler used by any function that is passed a nil client.
+// Passing nil restores the default, a plain http.Client. A client set on the context with WithHTTPClient takes
+// precedence over both.
+func SetDefaultClient(client HTTPClientHandler) {
+	if client == nil {
+		client = &http.Client{}
+	}
+
+	cfgMu.Lock()
+	defaultClient = client
+	cfgMu.Unlock()
+}
+
+// resolveClient returns the client set on the context with WithHTTPClient, then the passed in client if set,
+// otherwise the package level default client.
+func resolveClient(ctx context.Context, client HTTPClientHandler) HTTPClientHandler {
+	if ctxClient := clientFrom(ctx); ctxClient != nil {
+		return ctxClient
+	}
+
+	if client != nil {
+		return client
+	}
+
+	cfgMu.RLock()
+	defer cfgMu.RUnlock()
+
+	return defaultClient
+}
+
+// SetEndpointTimeout overrides the default request timeout for a single endpoint. The path is matched
+// against the request path, eg: /IEconItems_440/GetSchemaItems/v1. Matching ignores case and trailing slashes.
+// A timeout of 0 or less removes the override.
+func SetEndpointTimeout(path string, timeout time.Duration) {
+	cfgMu.Lock()
+	defer cfgMu.Unlock()
+
+	if timeout <= 0 {
+		delete(endpointTimeouts, normalizeEndpointPath(path))
+
+		return
+	}
+
+	endpointTimeouts[normalizeEndpointPath(path)] = timeout
+}
+
+// requestTimeout returns the timeout to use for the endpoint path, falling back to defaultRequestTimeout.
+func requestTimeout(path string) time.Duration {
+	cfgMu.RLock()
+	defer cfgMu.RUnlock()
+
+	if timeout, found := endpointTimeouts[normalizeEndpointPath(path)]; found {
+		return timeout
+	}
+
+	return defaultRequestTimeout
+}
+
+func normalizeEndpointPath(path string) string {
+	return strings.TrimSuffix(strings.ToLower(path), "/")
+}
+
 // App is a known steam application.
 type App struct {
 	AppID int    `json:"appid"`
@@ -114,6 +366,7 @@ type App struct {
 }
 
 // GetAppList Full list of every publicly facing program in the store/library.
+// Results are cached, the returned slice is shared and must not be modified.
 func GetAppList(ctx context.Context, client HTTPClientHandler) ([]App, error) {
 	type response struct {
 		AppList struct {
@@ -121,6 +374,10 @@ func GetAppList(ctx context.Context, client HTTPClientHandler) ([]App, error) {
 		} `json:"applist"`
 	}
 
+	if apps, found := getCached[[]App](ctx, cacheFrom(ctx), cacheKeyAppList); found {
+		return apps, nil
+	}
+
 	var resp response
 
 	errResp := apiRequest(ctx, client, "/ISteamApps/GetAppList/v2", nil, &resp)
@@ -128,58 +385,393 @@ func GetAppList(ctx context.Context, client HTTPClientHandler) ([]App, error) {
 		return nil, errResp
 	}
 
+	cacheFrom(ctx).set(cacheKeyAppList, resp.AppList.Apps, cacheTTL(ctx, defaultCacheTTL))
+	cacheFrom(ctx).set(cacheKeyAppIndex, newAppIndex(resp.AppList.Apps), cacheTTL(ctx, defaultCacheTTL))
+
 	return resp.AppList.Apps, nil
 }
 
-// apiRequest is the base function that facilitates all HTTP requests to the API.
+// StreamAppList calls fn for each app in the full app list as it is decoded, stopping early when fn returns false.
+// Unlike GetAppList, the list is never held in memory as a whole, which makes it suitable for finding a handful
+// of apps in memory constrained environments. The cached list is used when GetAppList has already populated it,
+// but StreamAppList never populates the cache itself.
+func StreamAppList(ctx context.Context, client HTTPClientHandler, fn func(App) bool) error {
+	if apps, found := getCached[[]App](ctx, cacheFrom(ctx), cacheKeyAppList); found {
+		for _, app := range apps {
+			if !fn(app) {
+				break
+			}
+		}
+
+		return nil
+	}
+
+	return apiRequest(ctx, client, "/ISteamApps/GetAppList/v2", nil, &appListStream{fn: fn})
+}
+
+// appListStream decodes the {"applist":{"apps":[...]}} response one app at a time.
+type appListStream struct {
+	fn func(App) bool
+}
+
+func (s *appListStream) decodeStream(dec *json.Decoder) error {
+	done, errApps := enterJSONKey(dec, "applist")
+	if errApps != nil || done {
+		return errApps
+	}
+
+	done, errApps = enterJSONKey(dec, "apps")
+	if errApps != nil || done {
+		return errApps
+	}
+
+	if errDelim := expectJSONDelim(dec, '['); errDelim != nil {
+		return errDelim
+	}
+
+	for dec.More() {
+		var app App
+		if errDecode := dec.Decode(&app); errDecode != nil {
+			return errors.Wrap(errDecode, "Failed to decode app")
+		}
+
+		if !s.fn(app) {
+			return nil
+		}
+	}
+
+	return nil
+}
+
+// enterJSONKey reads the opening brace of an object and advances the decoder to the value of key, skipping any
+// other members. done is true when the object does not contain the key.
+func enterJSONKey(dec *json.Decoder, key string) (bool, error) {
+	if errDelim := expectJSONDelim(dec, '{'); errDelim != nil {
+		return false, errDelim
+	}
+
+	for dec.More() {
+		token, errToken := dec.Token()
+		if errToken != nil {
+			return false, errors.Wrap(errToken, "Failed to read JSON key")
+		}
+
+		if token == key {
+			return false, nil
+		}
+
+		var skip json.RawMessage
+		if errSkip := dec.Decode(&skip); errSkip != nil {
+			return false, errors.Wrap(errSkip, "Failed to skip JSON value")
+		}
+	}
+
+	return true, nil
+}
+
+func expectJSONDelim(dec *json.Decoder, delim json.Delim) error {
+	token, errToken := dec.Token()
+	if errToken != nil {
+		return errors.Wrap(errToken, "Failed to read JSON token")
+	}
+
+	if token != delim {
+		return errors.Wrapf(ErrInvalidResponse, "Expected %s, got %v", delim, token)
+	}
+
+	return nil
+}
+
+// AppType is the category of a store app used by GetAppListByType.
+type AppType int
+
+// AppType options
+//
+//goland:noinspection ALL
+const (
+	AppTypeGame AppType = iota
+	AppTypeDLC
+	AppTypeSoftware
+	AppTypeVideo
+	AppTypeHardware
+)
+
+// storeAppListFlags maps each AppType to the IStoreService/GetAppList flag that includes it.
+var storeAppListFlags = map[AppType]string{ //nolint:gochecknoglobals
+	AppTypeGame:     "include_games",
+	AppTypeDLC:      "include_dlc",
+	AppTypeSoftware: "include_software",
+	AppTypeVideo:    "include_videos",
+	AppTypeHardware: "include_hardware",
+}
+
+func (t AppType) String() string {
+	switch t {
+	case AppTypeGame:
+		return "game"
+	case AppTypeDLC:
+		return "dlc"
+	case AppTypeSoftware:
+		return "software"
+	case AppTypeVideo:
+		return "video"
+	case AppTypeHardware:
+		return "hardware"
+	default:
+		return "unknown"
+	}
+}
+
+// StoreApp is an app listed on the store along with its type.
+type StoreApp struct {
+	AppID             steamid.AppID `json:"appid"`
+	Name              string        `json:"name"`
+	LastModified      int64         `json:"last_modified"`
+	PriceChangeNumber int64         `json:"price_change_number"`
+	Type              AppType       `json:"type"`
+}
+
+// storeAppListPageSize is the maximum number of results steam returns per IStoreService/GetAppList request.
+const storeAppListPageSize = 50000
+
+// GetAppListByType fetches the store app list for each of the requested types, tagging every app with its type.
+// When no types are given, all types are fetched. Unlike GetAppList, only apps available on the store are included.
+// Soundtracks are listed by steam as dlc.
+func GetAppListByType(ctx context.Context, client HTTPClientHandler, types ...AppType) ([]StoreApp, error) {
+	if len(types) == 0 {
+		types = []AppType{AppTypeGame, AppTypeDLC, AppTypeSoftware, AppTypeVideo, AppTypeHardware}
+	}
+
+	var apps []StoreApp
+
+	for _, appType := range types {
+		typeApps, errApps := getStoreAppList(ctx, client, appType)
+		if errApps != nil {
+			return nil, errApps
+		}
+
+		apps = append(apps, typeApps...)
+	}
+
+	return apps, nil
+}
+
+// getStoreAppList pages through every app of a single type.
+func getStoreAppList(ctx context.Context, client HTTPClientHandler, appType AppType) ([]StoreApp, error) {
+	type response struct {
+		Response struct {
+			Apps            []StoreApp    `json:"apps"`
+			HaveMoreResults bool          `json:"have_more_results"`
+			LastAppID       steamid.AppID `json:"last_appid"`
+		} `json:"response"`
+	}
+
+	if _, found := storeAppListFlags[appType]; !found {
+		return nil, errors.Errorf("Unknown app type: %d", appType)
+	}
+
+	var (
+		apps      []StoreApp
+		lastAppID steamid.AppID
+	)
+
+	for {
+		values := url.Values{
+			"max_results": []string{fmt.Sprintf("%d", storeAppListPageSize)},
+			"last_appid":  []string{fmt.Sprintf("%d", lastAppID)},
+		}
+
+		// Explicitly set every flag since steam does not use the same default for each type.
+		for otherType, otherFlag := range storeAppListFlags {
+			values.Set(otherFlag, strconv.FormatBool(otherType == appType))
+		}
+
+		var resp response
+
+		if errResp := apiRequest(ctx, client, "/IStoreService/GetAppList/v1", values, &resp); errResp != nil {
+			return nil, errResp
+		}
+
+		for _, app := range resp.Response.Apps {
+			app.Type = appType
+			apps = append(apps, app)
+		}
+
+		if !resp.Response.HaveMoreResults || resp.Response.LastAppID == 0 {
+			break
+		}
+
+		lastAppID = resp.Response.LastAppID
+	}
+
+	return apps, nil
+}
+
+// apiRequest is the base function that facilitates all HTTP requests to the API. Failed requests are retried
+// according to the retry policy set with SetRetryPolicy and are subject to the circuit breaker set with
+// SetCircuitBreaker.
 func apiRequest(ctx context.Context, client HTTPClientHandler, path string, values url.Values, target any) error {
-	if apiKey == "" {
+	apiKey := keyFrom(ctx)
+
+	if errCircuit := circuit.allow(apiKey, path); errCircuit != nil {
+		return errCircuit
+	}
+
+	err := withRetry(ctx, func() error {
+		return doAPIRequest(ctx, client, path, values, target)
+	})
+
+	circuit.record(apiKey, path, err)
+
+	return err
+}
+
+// keyOptionalClient is implemented by clients which do not need an api key to serve requests, such as MockClient.
+type keyOptionalClient interface {
+	keyOptional() bool
+}
+
+// doAPIRequest performs a single request to the API.
+func doAPIRequest(ctx context.Context, client HTTPClientHandler, path string, values url.Values, target any) error {
+	httpClient := resolveClient(ctx, client)
+
+	key := keyFrom(ctx)
+	if optional, ok := httpClient.(keyOptionalClient); key == "" && (!ok || !optional.keyOptional()) {
 		return ErrNoAPIKey
 	}
 
-	c, cancel := context.WithTimeout(ctx, defaultRequestTimeout)
+	if errAcquire := lifecycle.acquire(); errAcquire != nil {
+		return errAcquire
+	}
+
+	defer lifecycle.release()
+
+	c, cancel := requestContext(ctx, requestTimeout(path))
 	defer cancel()
 
+	if c.Err() != nil {
+		return errors.Wrap(c.Err(), "Request cancelled")
+	}
+
 	req, err := http.NewRequestWithContext(c, http.MethodGet, fmt.Sprintf(baseURL, path), nil)
 	if err != nil {
 		return errors.Wrap(err, "Failed to create new request")
 	}
 
 	// TODO Should we make a new instance?
+	format := "json"
+	if formatted, ok := target.(formattedResponse); ok {
+		format = formatted.responseFormat()
+	}
+
 	if values != nil {
-		values.Set("key", apiKey)
-		values.Set("format", "json")
+		mergeExtraParams(ctx, values)
+		values.Set("key", key)
+		values.Set("format", format)
 		req.URL.RawQuery = values.Encode()
+	} else if extra := extraParamsFrom(ctx); len(extra) > 0 {
+		req.URL.RawQuery = extra.Encode()
+	}
+
+	debug := debugEnabled()
+	if debug {
+		debugf("Request: %s %s\n", req.Method, redactURL(req.URL))
 	}
 
-	resp, errG := client.Do(req)
+	resp, errG := httpClient.Do(req)
 	if errG != nil {
-		return errors.Wrap(errG, "Failed to perform http request")
+		return errors.Wrap(wrapRequestError(ctx, errG), "Failed to perform http request")
 	}
 
 	defer func() {
 		_ = resp.Body.Close()
 	}()
 
-	if errU := json.NewDecoder(resp.Body).Decode(&target); errU != nil {
-		return errors.Wrap(errU, "Failed to decode JSON response")
+	var body io.Reader = newLimitedBody(resp.Body)
+
+	if debug {
+		raw, errRead := io.ReadAll(body)
+		if errRead != nil {
+			return errors.Wrap(errRead, "Failed to read response body")
+		}
+
+		debugf("Response: %d %s\n%s\n", resp.StatusCode, req.URL.Path, raw)
+
+		body = bytes.NewReader(raw)
 	}
 
+	// Error responses are frequently html or empty, so the status must be checked before trying to decode.
 	if resp.StatusCode != http.StatusOK {
-		if resp.StatusCode == http.StatusServiceUnavailable {
-			return ErrServiceUnavailable
+		return &StatusError{StatusCode: resp.StatusCode, Path: req.URL.Path}
+	}
+
+	body, errEmpty := nonEmptyBody(body)
+	if errEmpty != nil {
+		return errEmpty
+	}
+
+	if formatted, ok := target.(formattedResponse); ok {
+		if errU := formatted.decodeResponse(body); errU != nil {
+			return errors.Wrapf(errU, "Failed to decode %s response", format)
 		}
 
-		if resp.StatusCode == http.StatusTooManyRequests {
-			return ErrServiceRateLimit
+		return nil
+	}
+
+	if stream, ok := target.(streamDecoder); ok {
+		if errU := stream.decodeStream(newResponseDecoder(body)); errU != nil {
+			return errors.Wrap(errU, "Failed to decode JSON response")
 		}
 
-		return errors.Errorf("Invalid status code received: %d", resp.StatusCode)
+		return nil
+	}
+
+	if errU := newResponseDecoder(body).Decode(&target); errU != nil {
+		return errors.Wrap(errU, "Failed to decode JSON response")
 	}
 
 	return nil
 }
 
+// nonEmptyBody returns a reader over the body, or ErrEmptyResponse when the body is empty or contains only
+// whitespace. Without this, empty bodies surface as a confusing EOF decode error.
+func nonEmptyBody(body io.Reader) (io.Reader, error) {
+	reader := bufio.NewReader(body)
+
+	for {
+		char, errRead := reader.ReadByte()
+		if errRead != nil {
+			if errors.Is(errRead, io.EOF) {
+				return nil, ErrEmptyResponse
+			}
+
+			return nil, errors.Wrap(errRead, "Failed to read response body")
+		}
+
+		switch char {
+		case ' ', '\t', '\r', '\n':
+			continue
+		}
+
+		_ = reader.UnreadByte()
+
+		return reader, nil
+	}
+}
+
+// formattedResponse is implemented by request targets that use a response format other than json, eg: vdf.
+// The format is sent as the format parameter and the target decodes the body itself.
+type formattedResponse interface {
+	responseFormat() string
+	decodeResponse(body io.Reader) error
+}
+
+// streamDecoder is implemented by request targets that decode the response body incrementally instead of
+// unmarshalling it in full. Returning early, without reading the rest of the body, is allowed.
+type streamDecoder interface {
+	decodeStream(dec *json.Decoder) error
+}
+
 // PersonaState is the user's current account status.
 type PersonaState int
 
@@ -222,6 +814,41 @@ const (
 	VisibilityPublic
 )
 
+// CommentPermission controls who is allowed to comment on a users profile.
+type CommentPermission int
+
+// CommentPermission options
+//
+//goland:noinspection ALL
+const (
+	CommentPermissionNone CommentPermission = iota
+	CommentPermissionPublic
+	CommentPermissionFriendsOnly
+)
+
+// PersonaStateFlag is a single bit of the PlayerSummary.PersonaStateFlags bitmask.
+type PersonaStateFlag int
+
+// PersonaStateFlag options
+//
+//goland:noinspection ALL
+const (
+	PersonaFlagHasRichPresence    PersonaStateFlag = 1
+	PersonaFlagInJoinableGame     PersonaStateFlag = 2
+	PersonaFlagGolden             PersonaStateFlag = 4
+	PersonaFlagRemotePlayTogether PersonaStateFlag = 8
+	PersonaFlagClientTypeWeb      PersonaStateFlag = 256
+	PersonaFlagClientTypeMobile   PersonaStateFlag = 512
+	PersonaFlagClientTypeTenfoot  PersonaStateFlag = 1024
+	PersonaFlagClientTypeVR       PersonaStateFlag = 2048
+	PersonaFlagLaunchTypeGamepad  PersonaStateFlag = 4096
+)
+
+// personaFlagsClient are the flags set while the user is connected with a specific client, such as big picture
+// (tenfoot) or the mobile app.
+const personaFlagsClient = PersonaFlagClientTypeWeb | PersonaFlagClientTypeMobile | PersonaFlagClientTypeTenfoot |
+	PersonaFlagClientTypeVR
+
 // PlayerSummary is the unaltered player summary from the steam official API.
 type PlayerSummary struct {
 	SteamID                  steamid.SteamID `json:"steamid"`
@@ -237,20 +864,83 @@ type PlayerSummary struct {
 	RealName                 string          `json:"realname"`
 	PrimaryClanID            string          `json:"primaryclanid"`
 	TimeCreated              int             `json:"timecreated"`
-	// Bitmask
-	// 1: 'Offline',
-	// 2: 'Online',
-	// 4: 'Golden',
-	// 64: 'Online using Big Picture',
-	// 256: 'Online using Web Client',
-	// 512: 'Online using Mobile',
-	// 1024: 'Online using Steam Controller'
-	PersonaStateFlags int    `json:"personastateflags"`
-	LocCountryCode    string `json:"loccountrycode"`
-	LocStateCode      string `json:"locstatecode"`
-	LocCityID         int    `json:"loccityid"`
-	LastLogoff        int    `json:"lastlogoff"`
-	CommentPermission int    `json:"commentpermission"`
+	// Bitmask of PersonaStateFlag values, see HasPersonaStateFlag.
+	PersonaStateFlags int               `json:"personastateflags"`
+	LocCountryCode    string            `json:"loccountrycode"`
+	LocStateCode      string            `json:"locstatecode"`
+	LocCityID         int               `json:"loccityid"`
+	LastLogoff        int               `json:"lastlogoff"`
+	CommentPermission CommentPermission `json:"commentpermission"`
+	// The app id of the game the user is currently playing, if any.
+	GameID string `json:"gameid,omitempty"`
+	// The name of the game being played, this is also set for non-steam games.
+	GameExtraInfo string `json:"gameextrainfo,omitempty"`
+	// The address of the server the user is playing on, if any.
+	GameServerIP string `json:"gameserverip,omitempty"`
+}
+
+// HasPersonaStateFlag returns true if the flag is set in PersonaStateFlags.
+func (p PlayerSummary) HasPersonaStateFlag(flag PersonaStateFlag) bool {
+	return PersonaStateFlag(p.PersonaStateFlags)&flag == flag
+}
+
+// IsOnline returns true if the user is currently online in any state other than offline, or is connected using
+// a client such as the mobile app or web client, which steam reports via the flags. Private profiles always
+// report as offline.
+func (p PlayerSummary) IsOnline() bool {
+	return p.PersonaState != StateOffline || PersonaStateFlag(p.PersonaStateFlags)&personaFlagsClient != 0
+}
+
+// IsInGame returns true if the user is currently in a game, including non-steam games. The game fields are only
+// returned for public profiles, so the joinable game flag is also checked.
+func (p PlayerSummary) IsInGame() bool {
+	return p.GameID != "" || p.GameExtraInfo != "" || p.HasPersonaStateFlag(PersonaFlagInJoinableGame)
+}
+
+const (
+	// defaultAvatarHash is the avatar assigned to accounts that never set one.
+	defaultAvatarHash = "fef49e7fa7e1997310d705b2a6158ff8dc1cdfeb"
+	// recentAccountAge is how new an account must be to count as recently created by IsLikelyLimited.
+	recentAccountAge = time.Hour * 24 * 30
+	// limitedSignalThreshold is the number of signals IsLikelyLimited requires.
+	limitedSignalThreshold = 3
+)
+
+// IsLikelyLimited guesses whether the account is limited, that is it has not spent the $5 required to unlock
+// the full set of community features. Steam does not expose this, so it is a heuristic, not authoritative. It
+// counts the following signals and reports true when at least three are present:
+//   - the community profile was never set up
+//   - the account was created within the last 30 days
+//   - the profile is not public
+//   - the avatar was never changed from the default
+//   - no real name is set
+//
+// Limited accounts commonly show several of these, but so can legitimate new or privacy conscious users.
+func IsLikelyLimited(summary PlayerSummary) bool {
+	signals := 0
+
+	if summary.ProfileState == ProfileStateNew {
+		signals++
+	}
+
+	// TimeCreated is only returned for public profiles.
+	if summary.TimeCreated > 0 && time.Since(time.Unix(int64(summary.TimeCreated), 0)) < recentAccountAge {
+		signals++
+	}
+
+	if summary.CommunityVisibilityState != VisibilityPublic {
+		signals++
+	}
+
+	if summary.AvatarHash == "" || summary.AvatarHash == defaultAvatarHash {
+		signals++
+	}
+
+	if summary.RealName == "" {
+		signals++
+	}
+
+	return signals >= limitedSignalThreshold
 }
 
 // PlayerSummaries will call GetPlayerSummaries on the valve WebAPI returning the players
@@ -265,11 +955,11 @@ func PlayerSummaries(ctx context.Context, client HTTPClientHandler, steamIDs ste
 	}
 
 	if len(steamIDs) == 0 {
-		return nil, errors.New("Too few steam ids, min 1")
+		return nil, ErrTooFewIDs
 	}
 
 	if len(steamIDs) > maxSteamIDsPerRequest {
-		return nil, errors.New("Too many steam ids, max 100")
+		return nil, ErrTooManyIDs
 	}
 
 	var resp response
@@ -284,6 +974,74 @@ func PlayerSummaries(ctx context.Context, client HTTPClientHandler, steamIDs ste
 	return resp.Response.Players, errResp
 }
 
+// PlayerLinkPublicData is the publicly visible portion of a PlayerLinkDetails.
+type PlayerLinkPublicData struct {
+	SteamID                  steamid.SteamID `json:"steamid"`
+	VisibilityState          VisibilityState `json:"visibility_state"`
+	PrivacyState             int             `json:"privacy_state"`
+	ProfileState             ProfileState    `json:"profile_state"`
+	BanExpiresTime           int             `json:"ban_expires_time"`
+	AccountFlags             int             `json:"account_flags"`
+	SHADigestAvatar          string          `json:"sha_digest_avatar"`
+	PersonaName              string          `json:"persona_name"`
+	ProfileURL               string          `json:"profile_url"`
+	ContentCountryRestricted bool            `json:"content_country_restricted"`
+}
+
+// PlayerLinkPrivateData is the portion of a PlayerLinkDetails that depends on the profile visibility.
+type PlayerLinkPrivateData struct {
+	PersonaState      PersonaState `json:"persona_state"`
+	PersonaStateFlags int          `json:"persona_state_flags"`
+	TimeCreated       int          `json:"time_created"`
+	GameID            string       `json:"game_id"`
+	GameServerSteamID string       `json:"game_server_steam_id"`
+	GameExtraInfo     string       `json:"game_extra_info"`
+	LobbySteamID      string       `json:"lobby_steam_id"`
+	LastLogoffTime    int          `json:"last_logoff_time"`
+	LastSeenOnline    int          `json:"last_seen_online"`
+	GameOSType        int          `json:"game_os_type"`
+	GameDeviceType    int          `json:"game_device_type"`
+	GameDeviceName    string       `json:"game_device_name"`
+}
+
+// PlayerLinkDetails contains the data steam uses to render the hover mini-profiles.
+type PlayerLinkDetails struct {
+	PublicData  PlayerLinkPublicData  `json:"public_data"`
+	PrivateData PlayerLinkPrivateData `json:"private_data"`
+}
+
+// GetPlayerLinkDetails fetches the mini-profile details for up to 100 steamids in a single call.
+func GetPlayerLinkDetails(ctx context.Context, client HTTPClientHandler, steamIDs steamid.Collection) ([]PlayerLinkDetails, error) {
+	type response struct {
+		Response struct {
+			Accounts []PlayerLinkDetails `json:"accounts"`
+		} `json:"response"`
+	}
+
+	if len(steamIDs) == 0 {
+		return nil, ErrTooFewIDs
+	}
+
+	if len(steamIDs) > maxSteamIDsPerRequest {
+		return nil, ErrTooManyIDs
+	}
+
+	values := url.Values{}
+
+	for i, sid := range steamIDs {
+		values.Set(fmt.Sprintf("steamids[%d]", i), sid.String())
+	}
+
+	var resp response
+
+	errResp := apiRequest(ctx, client, "/IPlayerService/GetPlayerLinkDetails/v1", values, &resp)
+	if errResp != nil {
+		return nil, errResp
+	}
+
+	return resp.Response.Accounts, nil
+}
+
 // EconBanState  holds the users current economy ban status.
 type EconBanState string
 
@@ -307,6 +1065,22 @@ type PlayerBanState struct {
 	EconomyBan       EconBanState    `json:"EconomyBan"`
 }
 
+// IsBanned reports whether the player has any VAC, game, community or economy ban on record.
+func (s PlayerBanState) IsBanned() bool {
+	return s.VACBanned || s.NumberOfVACBans > 0 || s.NumberOfGameBans > 0 || s.CommunityBanned ||
+		s.EconomyBan == EconBanBanned
+}
+
+// LastBan returns the approximate time of the most recent VAC or game ban. Steam only reports the number of days
+// since the ban, so the result has day precision. False is returned when the player has no VAC or game bans.
+func (s PlayerBanState) LastBan() (time.Time, bool) {
+	if s.NumberOfVACBans == 0 && s.NumberOfGameBans == 0 {
+		return time.Time{}, false
+	}
+
+	return time.Now().AddDate(0, 0, -s.DaysSinceLastBan).Truncate(time.Hour * 24), true
+}
+
 // GetPlayerBans fetches a players known steam bans. This includes bans that have "aged out" and are hidden on profiles.
 // https://wiki.teamfortress.com/wiki/WebAPI/GetPlayerBans
 func GetPlayerBans(ctx context.Context, client HTTPClientHandler, steamIDs steamid.Collection) ([]PlayerBanState, error) {
@@ -315,11 +1089,11 @@ func GetPlayerBans(ctx context.Context, client HTTPClientHandler, steamIDs steam
 	}
 
 	if len(steamIDs) == 0 {
-		return nil, errors.New("Too few steam ids, min 1")
+		return nil, ErrTooFewIDs
 	}
 
 	if len(steamIDs) > maxSteamIDsPerRequest {
-		return nil, errors.New("Too many steam ids, max 100")
+		return nil, ErrTooManyIDs
 	}
 
 	var resp response
@@ -335,6 +1109,7 @@ func GetPlayerBans(ctx context.Context, client HTTPClientHandler, steamIDs steam
 }
 
 // GetUserGroupList returns a list of a users public groups.
+// ErrProfilePrivate is returned when the users groups are not public.
 func GetUserGroupList(ctx context.Context, client HTTPClientHandler, steamID steamid.SteamID) ([]steamid.SteamID, error) {
 	type GetUserGroupListResponse struct {
 		Response struct {
@@ -351,6 +1126,10 @@ func GetUserGroupList(ctx context.Context, client HTTPClientHandler, steamID ste
 	}, &resp)
 
 	if errResp != nil {
+		if errors.Is(errResp, ErrUnauthorized) {
+			return nil, ErrProfilePrivate
+		}
+
 		return nil, errResp
 	}
 
@@ -370,7 +1149,35 @@ type Friend struct {
 	FriendSince  int             `json:"friend_since"`
 }
 
+// Since returns when the friendship was formed. Friendships formed before steam tracked this return the zero time.
+func (f Friend) Since() time.Time {
+	if f.FriendSince <= 0 {
+		return time.Time{}
+	}
+
+	return time.Unix(int64(f.FriendSince), 0)
+}
+
+// FriendsSince returns the friends whose friendship was formed at or after after and before before, eg: the
+// friends added this month. A zero after or before leaves that side of the window open. Friendships without a
+// known date are never included. The input slice is not modified.
+func FriendsSince(friends []Friend, after time.Time, before time.Time) []Friend {
+	var filtered []Friend
+
+	for _, friend := range friends {
+		since := friend.Since()
+		if since.IsZero() || (!after.IsZero() && since.Before(after)) || (!before.IsZero() && !since.Before(before)) {
+			continue
+		}
+
+		filtered = append(filtered, friend)
+	}
+
+	return filtered
+}
+
 // GetFriendList returns all the users friends if public.
+// ErrProfilePrivate is returned when the users friends list is not public.
 func GetFriendList(ctx context.Context, client HTTPClientHandler, steamID steamid.SteamID) ([]Friend, error) {
 	type GetFriendListResponse struct {
 		FriendsList struct {
@@ -385,6 +1192,10 @@ func GetFriendList(ctx context.Context, client HTTPClientHandler, steamID steami
 	}, &resp)
 
 	if errResp != nil {
+		if errors.Is(errResp, ErrUnauthorized) {
+			return nil, ErrProfilePrivate
+		}
+
 		return nil, errResp
 	}
 
@@ -404,7 +1215,9 @@ type ServerAtAddress struct {
 	SpecPort int           `json:"specport"`
 }
 
-// GetServersAtAddress Shows all steam-compatible servers related to a IPv4 Address.
+// GetServersAtAddress Shows all steam-compatible servers related to a IPv4 Address. An empty slice is returned
+// when the address hosts no servers, an *AddressQueryError is returned when steam fails to query the address.
+// ErrIPv6Unsupported is returned for IPv6 addresses.
 func GetServersAtAddress(ctx context.Context, client HTTPClientHandler, ipAddr net.IP) ([]ServerAtAddress, error) {
 	type response struct {
 		Response struct {
@@ -413,6 +1226,11 @@ func GetServersAtAddress(ctx context.Context, client HTTPClientHandler, ipAddr n
 		} `json:"response"`
 	}
 
+	// Steam only supports querying IPv4 addresses.
+	if ipAddr.To4() == nil {
+		return nil, ErrIPv6Unsupported
+	}
+
 	var resp response
 
 	errResp := apiRequest(ctx, client, "/ISteamApps/GetServersAtAddress/v0001", url.Values{
@@ -424,7 +1242,11 @@ func GetServersAtAddress(ctx context.Context, client HTTPClientHandler, ipAddr n
 	}
 
 	if !resp.Response.Success {
-		return nil, errors.New("Invalid response")
+		return nil, &AddressQueryError{Addr: ipAddr}
+	}
+
+	if resp.Response.Servers == nil {
+		return []ServerAtAddress{}, nil
 	}
 
 	return resp.Response.Servers, nil
@@ -451,7 +1273,34 @@ type Server struct {
 	GameType   string `json:"gametype"`
 }
 
+// BuildServerFilter converts filters into the master server filter syntax, eg: \appid\440\map\cp_badlands.
+// Keys are sorted so the output is stable. The syntax uses backslashes as delimiters and has no way to escape
+// them, so ErrInvalidFilter is returned for any key or value containing one, as well as for empty keys. Other
+// characters such as spaces and commas, used to separate gametype tags, are passed through as is.
+func BuildServerFilter(filters map[string]string) (string, error) {
+	keys := make([]string, 0, len(filters))
+
+	for key, value := range filters {
+		if key == "" || strings.ContainsAny(key, "\\\x00") || strings.ContainsAny(value, "\\\x00") {
+			return "", errors.Wrapf(ErrInvalidFilter, "%q: %q", key, value)
+		}
+
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	var builder strings.Builder
+
+	for _, key := range keys {
+		builder.WriteString("\\" + key + "\\" + filters[key])
+	}
+
+	return builder.String(), nil
+}
+
 // GetServerList Shows all steam-compatible servers.
+// The filters are converted using BuildServerFilter.
 func GetServerList(ctx context.Context, client HTTPClientHandler, filters map[string]string) ([]Server, error) {
 	type response struct {
 		Response struct {
@@ -459,14 +1308,13 @@ func GetServerList(ctx context.Context, client HTTPClientHandler, filters map[st
 		} `json:"response"`
 	}
 
-	var resp response
-
-	filterStr := ""
-
-	for k, v := range filters {
-		filterStr += fmt.Sprintf("\\%s\\%s", k, v)
+	filterStr, errFilter := BuildServerFilter(filters)
+	if errFilter != nil {
+		return nil, errFilter
 	}
 
+	var resp response
+
 	errResp := apiRequest(ctx, client, "/IGameServersService/GetServerList/v1", url.Values{
 		"filter": []string{filterStr},
 		"limit":  []string{"25000"},
@@ -513,10 +1361,14 @@ func UpToDateCheck(ctx context.Context, client HTTPClientHandler, appID steamid.
 
 // GetNewsForAppOptions holds query options for fetching news.
 type GetNewsForAppOptions struct {
-	MaxLength uint32   `json:"max_length"`
-	EndDate   uint32   `json:"end_date"`
-	Count     uint32   `json:"count"`
-	Feeds     []string `json:"feeds"`
+	// MaxLength truncates the contents of each item to the length. Leaving it unset uses the steam default,
+	// which truncates the contents. Use FullContent to get the entire contents instead.
+	MaxLength uint32 `json:"max_length"`
+	// FullContent requests the untruncated contents of each item, taking precedence over MaxLength.
+	FullContent bool     `json:"full_content"`
+	EndDate     uint32   `json:"end_date"`
+	Count       uint32   `json:"count"`
+	Feeds       []string `json:"feeds"`
 }
 
 // NewsItem is an individual news entry.
@@ -550,7 +1402,10 @@ func GetNewsForApp(ctx context.Context, client HTTPClientHandler, appID steamid.
 	}
 
 	if opts != nil {
-		if opts.MaxLength > 0 {
+		// Steam only returns the full contents when maxlength is explicitly 0.
+		if opts.FullContent {
+			values.Set("maxlength", "0")
+		} else if opts.MaxLength > 0 {
 			values.Set("maxlength", fmt.Sprintf("%d", opts.MaxLength))
 		}
 
@@ -577,7 +1432,8 @@ func GetNewsForApp(ctx context.Context, client HTTPClientHandler, appID steamid.
 	return resp.AppNews.NewsItems, nil
 }
 
-// GetNumberOfCurrentPlayers Returns the current number of players for an app.
+// GetNumberOfCurrentPlayers Returns the current number of players for an app. When the context was created with
+// WithValidateAppID, ErrUnknownAppID is returned for app ids not in the app list.
 func GetNumberOfCurrentPlayers(ctx context.Context, client HTTPClientHandler, appID steamid.AppID) (int, error) {
 	type response struct {
 		Response struct {
@@ -586,6 +1442,10 @@ func GetNumberOfCurrentPlayers(ctx context.Context, client HTTPClientHandler, ap
 		} `json:"response"`
 	}
 
+	if errValidate := validateAppID(ctx, client, appID); errValidate != nil {
+		return 0, errValidate
+	}
+
 	var resp response
 
 	err := apiRequest(ctx, client, "/ISteamUserStats/GetNumberOfCurrentPlayers/v1", url.Values{
@@ -617,9 +1477,15 @@ type PlayerStats struct {
 }
 
 // GetUserStatsForGame currently 500 status with valid requests.
+// The game name is returned using the language set with WithLang, or SetLang when not set on the context.
+// ErrProfilePrivate is returned when the users stats are not public. A *PlayerStatsError is returned when steam
+// reports an error message for the stats, or responds with a 500 status.
 func GetUserStatsForGame(ctx context.Context, client HTTPClientHandler, steamID steamid.SteamID, appID steamid.AppID) (PlayerStats, error) {
 	type response struct {
-		PlayerStats PlayerStats `json:"playerstats"`
+		PlayerStats struct {
+			PlayerStats
+			Error string `json:"error"`
+		} `json:"playerstats"`
 	}
 
 	var resp response
@@ -627,12 +1493,352 @@ func GetUserStatsForGame(ctx context.Context, client HTTPClientHandler, steamID
 	errResp := apiRequest(ctx, client, "/ISteamUserStats/GetUserStatsForGame/v2", url.Values{
 		"steamid": []string{steamID.String()},
 		"appid":   []string{fmt.Sprintf("%d", appID)},
+		"l":       []string{langFrom(ctx)},
 	}, &resp)
 	if errResp != nil {
+		var statusErr *StatusError
+		if errors.As(errResp, &statusErr) &&
+			(statusErr.StatusCode == http.StatusForbidden || statusErr.StatusCode == http.StatusUnauthorized) {
+			return PlayerStats{}, ErrProfilePrivate
+		}
+
+		if errors.As(errResp, &statusErr) && statusErr.StatusCode == http.StatusInternalServerError {
+			return PlayerStats{}, &PlayerStatsError{SteamID: steamID, AppID: appID, Err: statusErr}
+		}
+
 		return PlayerStats{}, errResp
 	}
 
-	return resp.PlayerStats, nil
+	if resp.PlayerStats.Error != "" {
+		errStats := &PlayerStatsError{
+			SteamID: steamID,
+			AppID:   appID,
+			Message: resp.PlayerStats.Error,
+			Err:     ErrInvalidResponse,
+		}
+
+		if strings.Contains(strings.ToLower(resp.PlayerStats.Error), "not public") {
+			errStats.Err = ErrProfilePrivate
+		}
+
+		return PlayerStats{}, errStats
+	}
+
+	return resp.PlayerStats.PlayerStats, nil
+}
+
+// GameStatsSchemaStat describes a single stat available for a game.
+type GameStatsSchemaStat struct {
+	Name         string `json:"name"`
+	DefaultValue int    `json:"defaultvalue"`
+	DisplayName  string `json:"displayName"`
+}
+
+// GameStatsSchemaAchievement describes a single achievement available for a game.
+type GameStatsSchemaAchievement struct {
+	Name         string `json:"name"`
+	DefaultValue int    `json:"defaultvalue"`
+	DisplayName  string `json:"displayName"`
+	Hidden       int    `json:"hidden"`
+	Description  string `json:"description"`
+	Icon         string `json:"icon"`
+	IconGray     string `json:"icongray"`
+}
+
+// GameStatsSchema contains the definitions of all the stats and achievements for a game.
+type GameStatsSchema struct {
+	GameName           string `json:"gameName"`
+	GameVersion        string `json:"gameVersion"`
+	AvailableGameStats struct {
+		Stats        []GameStatsSchemaStat        `json:"stats"`
+		Achievements []GameStatsSchemaAchievement `json:"achievements"`
+	} `json:"availableGameStats"`
+}
+
+// GetGameStatsSchema fetches the stat and achievement definitions for a game. Display names and descriptions are
+// returned using the language set with WithLang, or SetLang when not set on the context.
+// Results are cached per app and language.
+func GetGameStatsSchema(ctx context.Context, client HTTPClientHandler, appID steamid.AppID) (*GameStatsSchema, error) {
+	type response struct {
+		Game GameStatsSchema `json:"game"`
+	}
+
+	userLang := langFrom(ctx)
+	key := newCacheKey(cacheKeyGameStatsSchema, appID, userLang)
+
+	if schema, found := getCached[GameStatsSchema](ctx, cacheFrom(ctx), key); found {
+		return &schema, nil
+	}
+
+	var resp response
+
+	errResp := apiRequest(ctx, client, "/ISteamUserStats/GetSchemaForGame/v2", url.Values{
+		"appid": []string{fmt.Sprintf("%d", appID)},
+		"l":     []string{userLang},
+	}, &resp)
+	if errResp != nil {
+		return nil, errResp
+	}
+
+	cacheFrom(ctx).set(key, resp.Game, cacheTTL(ctx, defaultCacheTTL))
+
+	return &resp.Game, nil
+}
+
+// DetailedStat is a users stat value joined with its schema definition.
+type DetailedStat struct {
+	Name        string `json:"name"`
+	DisplayName string `json:"display_name"`
+	Value       int    `json:"value"`
+}
+
+// DetailedAchievement is a users achievement state joined with its schema definition.
+type DetailedAchievement struct {
+	Name        string `json:"name"`
+	DisplayName string `json:"display_name"`
+	Description string `json:"description"`
+	Icon        string `json:"icon"`
+	IconGray    string `json:"icon_gray"`
+	Hidden      bool   `json:"hidden"`
+	Achieved    bool   `json:"achieved"`
+}
+
+// PlayerStatsDetailed contains a users stats and achievements with their display names and descriptions.
+type PlayerStatsDetailed struct {
+	SteamID      steamid.SteamID       `json:"steam_id"`
+	GameName     string                `json:"game_name"`
+	Stats        []DetailedStat        `json:"stats"`
+	Achievements []DetailedAchievement `json:"achievements"`
+}
+
+// GetUserStatsDetailed combines GetUserStatsForGame with GetGameStatsSchema so that each stat and achievement
+// includes its display name, description and icons. Stats missing from the schema use their raw name as the
+// display name. ErrProfilePrivate is returned when the users stats are not public.
+func GetUserStatsDetailed(ctx context.Context, client HTTPClientHandler, steamID steamid.SteamID, appID steamid.AppID) (*PlayerStatsDetailed, error) {
+	stats, errStats := GetUserStatsForGame(ctx, client, steamID, appID)
+	if errStats != nil {
+		return nil, errStats
+	}
+
+	schema, errSchema := GetGameStatsSchema(ctx, client, appID)
+	if errSchema != nil {
+		return nil, errSchema
+	}
+
+	schemaStats := make(map[string]GameStatsSchemaStat, len(schema.AvailableGameStats.Stats))
+	for _, stat := range schema.AvailableGameStats.Stats {
+		schemaStats[stat.Name] = stat
+	}
+
+	schemaAchievements := make(map[string]GameStatsSchemaAchievement, len(schema.AvailableGameStats.Achievements))
+	for _, achievement := range schema.AvailableGameStats.Achievements {
+		schemaAchievements[achievement.Name] = achievement
+	}
+
+	detailed := &PlayerStatsDetailed{
+		SteamID:      stats.SteamID,
+		GameName:     stats.GameName,
+		Stats:        make([]DetailedStat, len(stats.Stats)),
+		Achievements: make([]DetailedAchievement, len(stats.Achievements)),
+	}
+
+	for index, stat := range stats.Stats {
+		detailed.Stats[index] = DetailedStat{Name: stat.Name, DisplayName: stat.Name, Value: stat.Value}
+
+		if def, found := schemaStats[stat.Name]; found && def.DisplayName != "" {
+			detailed.Stats[index].DisplayName = def.DisplayName
+		}
+	}
+
+	for index, achievement := range stats.Achievements {
+		detailed.Achievements[index] = DetailedAchievement{
+			Name:        achievement.Name,
+			DisplayName: achievement.Name,
+			Achieved:    achievement.Achieved == 1,
+		}
+
+		if def, found := schemaAchievements[achievement.Name]; found {
+			detailed.Achievements[index].DisplayName = def.DisplayName
+			detailed.Achievements[index].Description = def.Description
+			detailed.Achievements[index].Icon = def.Icon
+			detailed.Achievements[index].IconGray = def.IconGray
+			detailed.Achievements[index].Hidden = def.Hidden == 1
+		}
+	}
+
+	return detailed, nil
+}
+
+// PlayerAchievement is the unlock state of a single achievement for a user.
+type PlayerAchievement struct {
+	APIName     string `json:"apiname"`
+	Achieved    int    `json:"achieved"`
+	UnlockTime  int64  `json:"unlocktime"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+// PlayerAchievements contains a users achievement state for a single game.
+type PlayerAchievements struct {
+	SteamID      steamid.SteamID     `json:"steamID"`
+	GameName     string              `json:"gameName"`
+	Achievements []PlayerAchievement `json:"achievements"`
+}
+
+// GetPlayerAchievements fetches the achievement unlock state of a user for a game. Names and descriptions are
+// returned using the language set with WithLang, or SetLang when not set on the context.
+// ErrProfilePrivate is returned when the users stats are not public.
+func GetPlayerAchievements(ctx context.Context, client HTTPClientHandler, steamID steamid.SteamID, appID steamid.AppID) (*PlayerAchievements, error) {
+	type response struct {
+		PlayerStats struct {
+			PlayerAchievements
+			Success bool   `json:"success"`
+			Error   string `json:"error"`
+		} `json:"playerstats"`
+	}
+
+	var resp response
+
+	errResp := apiRequest(ctx, client, "/ISteamUserStats/GetPlayerAchievements/v1", url.Values{
+		"steamid": []string{steamID.String()},
+		"appid":   []string{fmt.Sprintf("%d", appID)},
+		"l":       []string{langFrom(ctx)},
+	}, &resp)
+	if errResp != nil {
+		var statusErr *StatusError
+		if errors.As(errResp, &statusErr) &&
+			(statusErr.StatusCode == http.StatusForbidden || statusErr.StatusCode == http.StatusUnauthorized) {
+			return nil, ErrProfilePrivate
+		}
+
+		return nil, errResp
+	}
+
+	if !resp.PlayerStats.Success {
+		return nil, errors.Wrap(ErrInvalidResponse, resp.PlayerStats.Error)
+	}
+
+	return &resp.PlayerStats.PlayerAchievements, nil
+}
+
+// AchievementPercentage is the percentage of all players that have unlocked an achievement.
+type AchievementPercentage struct {
+	Name    string  `json:"name"`
+	Percent float64 `json:"percent"`
+}
+
+// GetGlobalAchievementPercentagesForApp fetches the global unlock percentages of every achievement for a game.
+func GetGlobalAchievementPercentagesForApp(ctx context.Context, client HTTPClientHandler, appID steamid.AppID) ([]AchievementPercentage, error) {
+	type response struct {
+		AchievementPercentages struct {
+			Achievements []struct {
+				Name string `json:"name"`
+				// Percent is sent as either a number or a string depending on the app.
+				Percent json.Number `json:"percent"`
+			} `json:"achievements"`
+		} `json:"achievementpercentages"`
+	}
+
+	var resp response
+
+	errResp := apiRequest(ctx, client, "/ISteamUserStats/GetGlobalAchievementPercentagesForApp/v2", url.Values{
+		"gameid": []string{fmt.Sprintf("%d", appID)},
+	}, &resp)
+	if errResp != nil {
+		return nil, errResp
+	}
+
+	percentages := make([]AchievementPercentage, len(resp.AchievementPercentages.Achievements))
+
+	for index, achievement := range resp.AchievementPercentages.Achievements {
+		percent, errPercent := achievement.Percent.Float64()
+		if errPercent != nil {
+			return nil, errors.Wrapf(ErrInvalidResponse, "invalid percent for %s", achievement.Name)
+		}
+
+		percentages[index] = AchievementPercentage{Name: achievement.Name, Percent: percent}
+	}
+
+	return percentages, nil
+}
+
+// LockedAchievement is an achievement a user has not yet unlocked along with its global unlock percentage.
+type LockedAchievement struct {
+	APIName       string  `json:"api_name"`
+	Name          string  `json:"name"`
+	Description   string  `json:"description"`
+	GlobalPercent float64 `json:"global_percent"`
+}
+
+// AchievementCompletion summarises a users progress towards unlocking all achievements in a game.
+type AchievementCompletion struct {
+	SteamID  steamid.SteamID `json:"steam_id"`
+	AppID    steamid.AppID   `json:"app_id"`
+	GameName string          `json:"game_name"`
+	Total    int             `json:"total"`
+	Unlocked int             `json:"unlocked"`
+	// Percent is the percentage of achievements unlocked, 0-100.
+	Percent float64 `json:"percent"`
+	// Locked contains the achievements not yet unlocked, ordered by global unlock percentage, most common first.
+	Locked []LockedAchievement `json:"locked"`
+}
+
+// GetPlayerAchievementCompletion combines GetPlayerAchievements with GetGlobalAchievementPercentagesForApp to
+// report a users completion progress for a game. The remaining locked achievements are sorted by how commonly
+// they are unlocked by other players, easiest to get first.
+// ErrProfilePrivate is returned when the users stats are not public.
+func GetPlayerAchievementCompletion(ctx context.Context, client HTTPClientHandler, steamID steamid.SteamID, appID steamid.AppID) (*AchievementCompletion, error) {
+	achievements, errAchievements := GetPlayerAchievements(ctx, client, steamID, appID)
+	if errAchievements != nil {
+		return nil, errAchievements
+	}
+
+	percentages, errPercentages := GetGlobalAchievementPercentagesForApp(ctx, client, appID)
+	if errPercentages != nil {
+		return nil, errPercentages
+	}
+
+	return newAchievementCompletion(appID, achievements, percentages), nil
+}
+
+func newAchievementCompletion(appID steamid.AppID, achievements *PlayerAchievements, percentages []AchievementPercentage) *AchievementCompletion {
+	global := make(map[string]float64, len(percentages))
+	for _, percentage := range percentages {
+		global[percentage.Name] = percentage.Percent
+	}
+
+	completion := &AchievementCompletion{
+		SteamID:  achievements.SteamID,
+		AppID:    appID,
+		GameName: achievements.GameName,
+		Total:    len(achievements.Achievements),
+		Locked:   []LockedAchievement{},
+	}
+
+	for _, achievement := range achievements.Achievements {
+		if achievement.Achieved == 1 {
+			completion.Unlocked++
+
+			continue
+		}
+
+		completion.Locked = append(completion.Locked, LockedAchievement{
+			APIName:       achievement.APIName,
+			Name:          achievement.Name,
+			Description:   achievement.Description,
+			GlobalPercent: global[achievement.APIName],
+		})
+	}
+
+	if completion.Total > 0 {
+		completion.Percent = float64(completion.Unlocked) / float64(completion.Total) * 100
+	}
+
+	sort.SliceStable(completion.Locked, func(i, j int) bool {
+		return completion.Locked[i].GlobalPercent > completion.Locked[j].GlobalPercent
+	})
+
+	return completion
 }
 
 // InventoryItem is an individual items from a users game inventory.
@@ -681,11 +1887,47 @@ func GetPlayerItems(ctx context.Context, client HTTPClientHandler, steamID steam
 	return resp.Result.Items, resp.Result.NumBackpackSlots, nil
 }
 
-// GetSchema retain legacy data shape by combining the new GetSchemaOverview and
-// GetSchemaItems results.
-// func GetSchema(appID steamid.AppID) ([]InventoryItem, error) {
-//	return nil, nil
-// }
+// Schema retains the legacy data shape by combining the GetSchemaOverview and GetSchemaItems results.
+type Schema struct {
+	Overview *SchemaOverview
+	// Items is shared with the GetSchemaItems cache and must not be modified.
+	Items      []SchemaItem
+	byDefIndex map[int]int
+}
+
+// Item returns the schema item with the defindex.
+func (s *Schema) Item(defIndex int) (SchemaItem, bool) {
+	index, found := s.byDefIndex[defIndex]
+	if !found {
+		return SchemaItem{}, false
+	}
+
+	return s.Items[index], true
+}
+
+func newSchema(overview *SchemaOverview, items []SchemaItem) *Schema {
+	byDefIndex := make(map[int]int, len(items))
+	for index, item := range items {
+		byDefIndex[item.DefIndex] = index
+	}
+
+	return &Schema{Overview: overview, Items: items, byDefIndex: byDefIndex}
+}
+
+// GetSchema fetches the schema overview and items of an app, both of which are cached.
+func GetSchema(ctx context.Context, client HTTPClientHandler, appID steamid.AppID) (*Schema, error) {
+	overview, errOverview := GetSchemaOverview(ctx, client, appID)
+	if errOverview != nil {
+		return nil, errOverview
+	}
+
+	items, errItems := GetSchemaItems(ctx, client, appID)
+	if errItems != nil {
+		return nil, errItems
+	}
+
+	return newSchema(overview, items), nil
+}
 
 // SchemaOverview contains all known attributes that an item might potentially have.
 type SchemaOverview struct {
@@ -765,11 +2007,18 @@ type SchemaOverview struct {
 
 // GetSchemaOverview undocumented newer endpoints, replaces GetSchema
 // https://github.com/SteamDatabase/SteamTracking/commit/e71a1cd100dc7f35f3f26e94f1bf58e6ce9957c4
+// Results are cached per app.
 func GetSchemaOverview(ctx context.Context, client HTTPClientHandler, appID steamid.AppID) (*SchemaOverview, error) {
 	type response struct {
 		Result SchemaOverview `json:"result"`
 	}
 
+	key := newCacheKey(cacheKeySchemaOverview, appID)
+
+	if overview, found := getCached[SchemaOverview](ctx, cacheFrom(ctx), key); found {
+		return &overview, nil
+	}
+
 	var resp response
 
 	errResp := apiRequest(ctx, client, fmt.Sprintf("/IEconItems_%d/GetSchemaOverview/v0001/", appID), url.Values{}, &resp)
@@ -777,6 +2026,8 @@ func GetSchemaOverview(ctx context.Context, client HTTPClientHandler, appID stea
 		return nil, errResp
 	}
 
+	cacheFrom(ctx).set(key, resp.Result, cacheTTL(ctx, defaultCacheTTL))
+
 	return &resp.Result, nil
 }
 
@@ -834,10 +2085,26 @@ type SchemaItem struct {
 	Attributes        []SchemaAttributes     `json:"attributes,omitempty"`
 }
 
+// GetSchemaItemsOptions controls the behaviour of GetSchemaItemsWithOptions.
+type GetSchemaItemsOptions struct {
+	// OnPage is called after each page of items is fetched with the total number of items fetched so far
+	// and the number of pages fetched. It is not called when the result is served from the cache.
+	OnPage func(fetched int, page int)
+}
+
 // GetSchemaItems undocumented newer endpoints
 // All paged results are fetched and merged
 // https://github.com/SteamDatabase/SteamTracking/commit/e71a1cd100dc7f35f3f26e94f1bf58e6ce9957c4
+// Results are cached per app, the returned slice is shared and must not be modified.
+// If ctx is cancelled part way through, the items fetched so far are returned along with the context error.
+// Partial results are not cached.
 func GetSchemaItems(ctx context.Context, client HTTPClientHandler, appID steamid.AppID) ([]SchemaItem, error) {
+	return GetSchemaItemsWithOptions(ctx, client, appID, nil)
+}
+
+// GetSchemaItemsWithOptions is GetSchemaItems with support for reporting paging progress. A nil opts behaves
+// the same as GetSchemaItems.
+func GetSchemaItemsWithOptions(ctx context.Context, client HTTPClientHandler, appID steamid.AppID, opts *GetSchemaItemsOptions) ([]SchemaItem, error) {
 	type response struct {
 		Result struct {
 			Status       int          `json:"status"`
@@ -847,33 +2114,55 @@ func GetSchemaItems(ctx context.Context, client HTTPClientHandler, appID steamid
 		} `json:"result"`
 	}
 
+	key := newCacheKey(cacheKeySchemaItems, appID)
+
+	if items, found := getCached[[]SchemaItem](ctx, cacheFrom(ctx), key); found {
+		return items, nil
+	}
+
 	var (
 		items []SchemaItem
-		page  = 0
+		start = 0
 	)
 
-	for {
+	for page := 1; ; page++ {
+		if errCtx := ctx.Err(); errCtx != nil {
+			return items, errCtx
+		}
+
 		var resp response
 
 		errResp := apiRequest(ctx, client, fmt.Sprintf("/IEconItems_%d/GetSchemaItems/v1/", appID), url.Values{
-			"start": []string{fmt.Sprintf("%d", page)},
+			"start": []string{fmt.Sprintf("%d", start)},
 		}, &resp)
 		if errResp != nil {
+			if errCtx := ctx.Err(); errCtx != nil {
+				return items, errCtx
+			}
+
 			return nil, errResp
 		}
 
+		items = append(items, resp.Result.Items...)
+
+		if opts != nil && opts.OnPage != nil {
+			opts.OnPage(len(items), page)
+		}
+
 		if resp.Result.Next == 0 {
 			break
 		}
 
-		items = append(items, resp.Result.Items...)
-		page = resp.Result.Next
+		start = resp.Result.Next
 	}
 
+	cacheFrom(ctx).set(key, items, cacheTTL(ctx, defaultCacheTTL))
+
 	return items, nil
 }
 
 // GetSchemaURL Returns a URL for the games' item_game.txt file.
+// Results are cached per app.
 func GetSchemaURL(ctx context.Context, client HTTPClientHandler, appID steamid.AppID) (string, error) {
 	type response struct {
 		Result struct {
@@ -882,6 +2171,12 @@ func GetSchemaURL(ctx context.Context, client HTTPClientHandler, appID steamid.A
 		} `json:"result"`
 	}
 
+	key := newCacheKey(cacheKeySchemaURL, appID)
+
+	if schemaURL, found := getCached[string](ctx, cacheFrom(ctx), key); found {
+		return schemaURL, nil
+	}
+
 	var resp response
 
 	errResp := apiRequest(ctx, client, fmt.Sprintf("/IEconItems_%d/GetSchemaURL/v0001/", appID), url.Values{}, &resp)
@@ -893,9 +2188,22 @@ func GetSchemaURL(ctx context.Context, client HTTPClientHandler, appID steamid.A
 		return "", ErrInvalidResponse
 	}
 
+	cacheFrom(ctx).set(key, resp.Result.ItemsGameURL, cacheTTL(ctx, defaultCacheTTL))
+
 	return resp.Result.ItemsGameURL, nil
 }
 
+// GetItemsGameText downloads the raw items_game.txt VDF file referenced by GetSchemaURL. This is the canonical
+// source of the full item definitions for a game.
+func GetItemsGameText(ctx context.Context, client HTTPClientHandler, appID steamid.AppID) ([]byte, error) {
+	schemaURL, errURL := GetSchemaURL(ctx, client, appID)
+	if errURL != nil {
+		return nil, errURL
+	}
+
+	return fetchRaw(ctx, client, schemaURL)
+}
+
 // Banners defines banners used in the store.
 type Banners struct {
 	BaseFilename string `json:"basefilename"`
@@ -1041,12 +2349,19 @@ type StoreMetaData struct {
 	HomePageData    HomePageData      `json:"home_page_data"`
 }
 
-// GetStoreMetaData Returns a URL for the games' item_game.txt file.
+// GetStoreMetaData Returns the item store layout and metadata for the game.
+// Results are cached per app.
 func GetStoreMetaData(ctx context.Context, client HTTPClientHandler, appID steamid.AppID) (*StoreMetaData, error) {
 	type response struct {
 		Result StoreMetaData `json:"result"`
 	}
 
+	key := newCacheKey(cacheKeyStoreMetaData, appID)
+
+	if storeMetaData, found := getCached[StoreMetaData](ctx, cacheFrom(ctx), key); found {
+		return &storeMetaData, nil
+	}
+
 	var resp response
 
 	err := apiRequest(ctx, client, fmt.Sprintf("/IEconItems_%d/GetStoreMetaData/v0001/", appID), url.Values{}, &resp)
@@ -1054,9 +2369,46 @@ func GetStoreMetaData(ctx context.Context, client HTTPClientHandler, appID steam
 		return nil, err
 	}
 
+	cacheFrom(ctx).set(key, resp.Result, cacheTTL(ctx, defaultCacheTTL))
+
 	return &resp.Result, nil
 }
 
+// PlayerClassByBaseName returns the player class with the matching base name, eg: scout. Matching ignores case.
+func (m StoreMetaData) PlayerClassByBaseName(name string) (PlayerClassData, bool) {
+	for _, class := range m.PlayerClassData {
+		if strings.EqualFold(class.BaseName, name) {
+			return class, true
+		}
+	}
+
+	return PlayerClassData{}, false
+}
+
+// PopularSchemaItems returns the schema items listed in HomePageData.PopularItems, in their store order.
+// Popular items that are missing from items are skipped.
+func (m StoreMetaData) PopularSchemaItems(items []SchemaItem) []SchemaItem {
+	byDefIndex := make(map[int]SchemaItem, len(items))
+	for _, item := range items {
+		byDefIndex[item.DefIndex] = item
+	}
+
+	popular := slices.Clone(m.HomePageData.PopularItems)
+	sort.SliceStable(popular, func(i, j int) bool {
+		return popular[i].Order < popular[j].Order
+	})
+
+	found := make([]SchemaItem, 0, len(popular))
+
+	for _, item := range popular {
+		if schemaItem, ok := byDefIndex[item.DefIndex]; ok {
+			found = append(found, schemaItem)
+		}
+	}
+
+	return found
+}
+
 // SupportedAPIMethods returns known api methods.
 type SupportedAPIMethods struct {
 	Name       string                  `json:"name"`
@@ -1090,6 +2442,7 @@ type SupportedAPIInterfaces struct {
 }
 
 // GetSupportedAPIList Lists all available WebAPI interfaces.
+// Results are cached, the returned slice is shared and must not be modified.
 func GetSupportedAPIList(ctx context.Context, client HTTPClientHandler) ([]SupportedAPIInterfaces, error) {
 	type response struct {
 		Apilist struct {
@@ -1097,6 +2450,10 @@ func GetSupportedAPIList(ctx context.Context, client HTTPClientHandler) ([]Suppo
 		} `json:"apilist"`
 	}
 
+	if interfaces, found := getCached[[]SupportedAPIInterfaces](ctx, cacheFrom(ctx), cacheKeySupportedAPIList); found {
+		return interfaces, nil
+	}
+
 	var resp response
 
 	errResp := apiRequest(ctx, client, "/ISteamWebAPIUtil/GetSupportedAPIList/v0001/", url.Values{}, &resp)
@@ -1104,9 +2461,66 @@ func GetSupportedAPIList(ctx context.Context, client HTTPClientHandler) ([]Suppo
 		return nil, errResp
 	}
 
+	cacheFrom(ctx).set(cacheKeySupportedAPIList, resp.Apilist.Interfaces, cacheTTL(ctx, defaultCacheTTL))
+
 	return resp.Apilist.Interfaces, nil
 }
 
+// findAPIInterface returns the interface with the matching name, ignoring case.
+func findAPIInterface(interfaces []SupportedAPIInterfaces, name string) (SupportedAPIInterfaces, bool) {
+	for _, iface := range interfaces {
+		if strings.EqualFold(iface.Name, name) {
+			return iface, true
+		}
+	}
+
+	return SupportedAPIInterfaces{}, false
+}
+
+// GetSupportedAPIInterface returns a single interface, eg: ISteamUser, from the (cached) GetSupportedAPIList
+// results. The name is matched ignoring case. ErrInterfaceNotFound is returned when the interface is not listed.
+// The returned methods are shared with the cached list and must not be modified.
+func GetSupportedAPIInterface(ctx context.Context, client HTTPClientHandler, name string) (*SupportedAPIInterfaces, error) {
+	interfaces, errList := GetSupportedAPIList(ctx, client)
+	if errList != nil {
+		return nil, errList
+	}
+
+	apiInterface, found := findAPIInterface(interfaces, name)
+	if !found {
+		return nil, errors.Wrap(ErrInterfaceNotFound, name)
+	}
+
+	return &apiInterface, nil
+}
+
+// EndpointAvailable checks the (cached) GetSupportedAPIList results for the interface method, eg: ISteamUser,
+// GetPlayerSummaries. If found, the highest available version of the method is also returned. This can be used
+// to detect when steam releases a newer version of an endpoint than the one used by this package.
+func EndpointAvailable(ctx context.Context, client HTTPClientHandler, iface string, method string) (bool, int, error) {
+	interfaces, errList := GetSupportedAPIList(ctx, client)
+	if errList != nil {
+		return false, 0, errList
+	}
+
+	apiInterface, found := findAPIInterface(interfaces, iface)
+	if !found {
+		return false, 0, nil
+	}
+
+	available := false
+	version := 0
+
+	for _, apiMethod := range apiInterface.Methods {
+		if strings.EqualFold(apiMethod.Name, method) {
+			available = true
+			version = max(version, apiMethod.Version)
+		}
+	}
+
+	return available, version, nil
+}
+
 const steam64Len = 17
 
 // ResolveVanityURL Resolve vanity URL parts to a 64-bit ID.
@@ -1142,17 +2556,57 @@ func ResolveVanityURL(ctx context.Context, client HTTPClientHandler, query strin
 			query = query[0 : len(query)-1]
 		}
 
-		query = query[strings.Index(query, "steamcommunity.com/id/")+len("steamcommunity.com/id/"):]
+		query = query[strings.Index(query, "steamcommunity.com/id/")+len("steamcommunity.com/id/"):]
+	}
+
+	var resp response
+
+	errResp := apiRequest(ctx, client, "/ISteamUser/ResolveVanityURL/v0001/", url.Values{"vanityurl": []string{query}}, &resp)
+	if errResp != nil {
+		return steamid.SteamID{}, errResp
+	}
+
+	return resp.Response.SteamID, nil
+}
+
+// ResolveAny resolves any user supplied identifier into a steam id. Supported inputs are SteamID64, SteamID2
+// (STEAM_0:0:123), SteamID3 ([U:1:123]), profile urls, vanity urls and vanity names. The network is only used when
+// the input can not be parsed locally.
+func ResolveAny(ctx context.Context, client HTTPClientHandler, input string) (steamid.SteamID, error) {
+	query := strings.TrimSpace(input)
+
+	if isLocalSteamID(query) {
+		if sid := steamid.New(query); sid.Valid() {
+			return sid, nil
+		}
+	}
+
+	sid, errResolve := ResolveVanityURL(ctx, client, query)
+	if errResolve != nil {
+		return steamid.SteamID{}, errResolve
+	}
+
+	if !sid.Valid() {
+		return steamid.SteamID{}, fmt.Errorf("%w: %s", errInvalidID, input)
+	}
+
+	return sid, nil
+}
+
+// isLocalSteamID checks if the query is in one of the steam id formats that can be parsed without a lookup. Shorter
+// numeric strings are not considered as they are also valid vanity names.
+func isLocalSteamID(query string) bool {
+	if strings.HasPrefix(strings.ToUpper(query), "STEAM_") || strings.HasPrefix(strings.ToUpper(query), "[U:") {
+		return true
 	}
 
-	var resp response
-
-	errResp := apiRequest(ctx, client, "/ISteamUser/ResolveVanityURL/v0001/", url.Values{"vanityurl": []string{query}}, &resp)
-	if errResp != nil {
-		return steamid.SteamID{}, errResp
+	if len(query) != steam64Len {
+		return false
 	}
 
-	return resp.Response.SteamID, nil
+	_, errParse := strconv.ParseUint(query, 10, 64)
+
+	return errParse == nil
 }
 
 // GetSteamLevel Lists all available WebAPI interfaces.
@@ -1189,12 +2643,65 @@ type RecentGame struct {
 	PlaytimeLinuxForever   int           `json:"playtime_linux_forever"`
 }
 
+// GetAppID returns the games app id.
+func (g RecentGame) GetAppID() steamid.AppID {
+	return g.AppID
+}
+
+// GetName returns the games title.
+func (g RecentGame) GetName() string {
+	return g.Name
+}
+
+// IconURL returns an url to the game icon image.
+func (g RecentGame) IconURL() string {
+	return appImageURL(g.AppID, g.ImgIconURL)
+}
+
+// LogoURL returns an url to the game logo image.
+func (g RecentGame) LogoURL() string {
+	return appImageURL(g.AppID, g.ImgLogoURL)
+}
+
+// HeaderImageURL returns an url to the games store header image.
+func (g RecentGame) HeaderImageURL() string {
+	return appHeaderImageURL(g.AppID)
+}
+
+// Game is implemented by both OwnedGame and RecentGame so either can be handled the same way.
+type Game interface {
+	GetAppID() steamid.AppID
+	GetName() string
+	IconURL() string
+	LogoURL() string
+	HeaderImageURL() string
+}
+
+func appImageURL(appID steamid.AppID, hash string) string {
+	return fmt.Sprintf("https://media.steampowered.com/steamcommunity/public/images/apps/%d/%s.jpg", appID, hash)
+}
+
+func appHeaderImageURL(appID steamid.AppID) string {
+	return fmt.Sprintf("https://cdn.cloudflare.steamstatic.com/steam/apps/%d/header.jpg", appID)
+}
+
 // GetRecentlyPlayedGames Lists recently played games
 // No results returned is usually due to privacy settings.
 func GetRecentlyPlayedGames(ctx context.Context, client HTTPClientHandler, sid steamid.SteamID) ([]RecentGame, error) {
+	games, _, errGames := getRecentlyPlayedGames(ctx, client, sid)
+	if errGames != nil {
+		return nil, errGames
+	}
+
+	return games, nil
+}
+
+// getRecentlyPlayedGames performs the GetRecentlyPlayedGames request. Steam omits the total count entirely when
+// the profile is private, which is reported via the returned bool.
+func getRecentlyPlayedGames(ctx context.Context, client HTTPClientHandler, sid steamid.SteamID) ([]RecentGame, bool, error) {
 	type response struct {
 		Response struct {
-			TotalCount int          `json:"total_count"`
+			TotalCount *int         `json:"total_count"`
 			Games      []RecentGame `json:"games"`
 		} `json:"response"`
 	}
@@ -1206,10 +2713,10 @@ func GetRecentlyPlayedGames(ctx context.Context, client HTTPClientHandler, sid s
 		"count":   []string{"10"},
 	}, &resp)
 	if errResp != nil {
-		return nil, errResp
+		return nil, false, errResp
 	}
 
-	return resp.Response.Games, nil
+	return resp.Response.Games, resp.Response.TotalCount == nil, nil
 }
 
 // OwnedGame contains metadata about a users owned game.
@@ -1230,40 +2737,309 @@ type OwnedGame struct {
 	HasCommunityVisibleStats bool   `json:"has_community_visible_stats,omitempty"`
 	// An integer of the player's playtime in the past 2 weeks, denoted in minutes.
 	Playtime2Weeks int `json:"playtime_2weeks,omitempty"`
+	// Unix timestamp of the last time the game was played.
+	RTimeLastPlayed int `json:"rtime_last_played,omitempty"`
+}
+
+// GetAppID returns the games app id.
+func (g OwnedGame) GetAppID() steamid.AppID {
+	return g.AppID
+}
+
+// GetName returns the games title.
+func (g OwnedGame) GetName() string {
+	return g.Name
 }
 
 // IconURL returns an url to the game icon image.
 func (g OwnedGame) IconURL() string {
-	return fmt.Sprintf("https://media.steampowered.com/steamcommunity/public/images/apps/%d/%s.jpg", g.AppID, g.ImgIconURL)
+	return appImageURL(g.AppID, g.ImgIconURL)
 }
 
 // LogoURL returns an url to the game logo image.
 func (g OwnedGame) LogoURL() string {
-	return fmt.Sprintf("https://media.steampowered.com/steamcommunity/public/images/apps/%d/%s.jpg", g.AppID, g.ImgLogoURL)
+	return appImageURL(g.AppID, g.ImgLogoURL)
+}
+
+// HeaderImageURL returns an url to the games store header image.
+func (g OwnedGame) HeaderImageURL() string {
+	return appHeaderImageURL(g.AppID)
+}
+
+// OwnedGames is a list of games as returned by GetOwnedGames.
+type OwnedGames []OwnedGame
+
+// ResolveNames fills in the Name of any games that are missing it using the provided app index, such as one built
+// from GetAppList. Unlike ResolveAppNames no requests are made, games not found in the index are left unnamed.
+func (games OwnedGames) ResolveNames(index map[steamid.AppID]App) {
+	for i := range games {
+		if games[i].Name != "" {
+			continue
+		}
+
+		if app, found := index[games[i].AppID]; found {
+			games[i].Name = app.Name
+		}
+	}
+}
+
+// GetOwnedGamesOptions controls which details are included by GetOwnedGamesWithOptions.
+type GetOwnedGamesOptions struct {
+	// Include the game name and image information. Without this only the appid and playtimes are returned.
+	IncludeAppInfo bool
+	// Include free games that the user has played.
+	IncludePlayedFreeGames bool
+	// Only return results for these apps.
+	AppIDsFilter []steamid.AppID
 }
 
 // GetOwnedGames Lists all owned games
+// No results returned is usually due to privacy settings. Results are cached per user and options for 5 minutes by
+// default, see SetProfileCacheTTL.
+func GetOwnedGames(ctx context.Context, client HTTPClientHandler, sid steamid.SteamID) (OwnedGames, error) {
+	return GetOwnedGamesWithOptions(ctx, client, sid, nil)
+}
+
+// GetOwnedGamesWithOptions Lists owned games using the provided options. A nil opts includes app info and played
+// free games, the same as GetOwnedGames.
 // No results returned is usually due to privacy settings.
-func GetOwnedGames(ctx context.Context, client HTTPClientHandler, sid steamid.SteamID) ([]OwnedGame, error) {
+func GetOwnedGamesWithOptions(ctx context.Context, client HTTPClientHandler, sid steamid.SteamID, opts *GetOwnedGamesOptions) (OwnedGames, error) {
+	games, _, errGames := getOwnedGames(ctx, client, sid, opts)
+	if errGames != nil {
+		return nil, errGames
+	}
+
+	return games, nil
+}
+
+// ownedGamesResult is the cached result of getOwnedGames.
+type ownedGamesResult struct {
+	games   []OwnedGame
+	private bool
+}
+
+// getOwnedGames performs the GetOwnedGames request. Steam omits the game count entirely when the profile
+// is private, which is reported via the returned bool. Results are cached per user and options, a copy of the
+// cached games is returned so callers such as ResolveAppNames may modify them.
+func getOwnedGames(ctx context.Context, client HTTPClientHandler, sid steamid.SteamID, opts *GetOwnedGamesOptions) ([]OwnedGame, bool, error) {
 	type response struct {
 		Response struct {
-			GameCount int         `json:"game_count"`
+			GameCount *int        `json:"game_count"`
 			Games     []OwnedGame `json:"games"`
 		} `json:"response"`
 	}
 
-	var resp response
+	if opts == nil {
+		opts = &GetOwnedGamesOptions{IncludeAppInfo: true, IncludePlayedFreeGames: true}
+	}
 
-	errResp := apiRequest(ctx, client, "/IPlayerService/GetOwnedGames/v1", url.Values{
+	key := newCacheKey(cacheKeyOwnedGames, sid.String(), opts.IncludeAppInfo, opts.IncludePlayedFreeGames,
+		opts.AppIDsFilter)
+
+	if cached, found := getCached[ownedGamesResult](ctx, cacheFrom(ctx), key); found {
+		return slices.Clone(cached.games), cached.private, nil
+	}
+
+	values := url.Values{
 		"steamid":                   []string{sid.String()},
-		"include_appinfo":           []string{"true"},
-		"include_played_free_games": []string{"true"},
+		"include_appinfo":           []string{strconv.FormatBool(opts.IncludeAppInfo)},
+		"include_played_free_games": []string{strconv.FormatBool(opts.IncludePlayedFreeGames)},
+	}
+
+	for i, appID := range opts.AppIDsFilter {
+		values.Set(fmt.Sprintf("appids_filter[%d]", i), fmt.Sprintf("%d", appID))
+	}
+
+	var resp response
+
+	errResp := apiRequest(ctx, client, "/IPlayerService/GetOwnedGames/v1", values, &resp)
+	if errResp != nil {
+		return nil, false, errResp
+	}
+
+	result := ownedGamesResult{games: resp.Response.Games, private: resp.Response.GameCount == nil}
+
+	cacheFrom(ctx).set(key, result, cacheTTL(ctx, profileTTL()))
+
+	return slices.Clone(result.games), result.private, nil
+}
+
+// ResolveAppNames fills in the Name of any games that are missing it using GetAppList. This allows using the
+// cheaper GetOwnedGamesWithOptions call without IncludeAppInfo while still having names available. The app
+// list is only fetched if any names are missing.
+func ResolveAppNames(ctx context.Context, client HTTPClientHandler, games []OwnedGame) error {
+	missing := false
+
+	for _, game := range games {
+		if game.Name == "" {
+			missing = true
+
+			break
+		}
+	}
+
+	if !missing {
+		return nil
+	}
+
+	apps, errApps := getAppIndex(ctx, client)
+	if errApps != nil {
+		return errApps
+	}
+
+	for index := range games {
+		if games[index].Name == "" {
+			if app, found := apps.find(games[index].AppID); found {
+				games[index].Name = app.Name
+			}
+		}
+	}
+
+	return nil
+}
+
+// GamePlaytime contains a users playtime for a single game, all playtimes are denoted in minutes.
+type GamePlaytime struct {
+	AppID                  steamid.AppID `json:"appid"`
+	PlaytimeForever        int           `json:"playtime_forever"`
+	Playtime2Weeks         int           `json:"playtime_2weeks"`
+	PlaytimeWindowsForever int           `json:"playtime_windows_forever"`
+	PlaytimeMacForever     int           `json:"playtime_mac_forever"`
+	PlaytimeLinuxForever   int           `json:"playtime_linux_forever"`
+	// LastPlayed is zero if the game has never been played.
+	LastPlayed time.Time `json:"last_played"`
+}
+
+// GetSingleGamePlaytime fetches the users playtime for a single game. This is much cheaper than fetching the
+// full owned games list when only one game is of interest.
+// ErrProfilePrivate is returned when the users games are not public and ErrGameNotOwned when the user does not own
+// the game.
+func GetSingleGamePlaytime(ctx context.Context, client HTTPClientHandler, sid steamid.SteamID, appID steamid.AppID) (*GamePlaytime, error) {
+	games, private, errGames := getOwnedGames(ctx, client, sid, &GetOwnedGamesOptions{
+		IncludePlayedFreeGames: true,
+		AppIDsFilter:           []steamid.AppID{appID},
+	})
+	if errGames != nil {
+		return nil, errGames
+	}
+
+	if private {
+		return nil, ErrProfilePrivate
+	}
+
+	for _, game := range games {
+		if game.AppID != appID {
+			continue
+		}
+
+		playtime := &GamePlaytime{
+			AppID:                  game.AppID,
+			PlaytimeForever:        game.PlaytimeForever,
+			Playtime2Weeks:         game.Playtime2Weeks,
+			PlaytimeWindowsForever: game.PlaytimeWindowsForever,
+			PlaytimeMacForever:     game.PlaytimeMacForever,
+			PlaytimeLinuxForever:   game.PlaytimeLinuxForever,
+		}
+
+		if game.RTimeLastPlayed > 0 {
+			playtime.LastPlayed = time.Unix(int64(game.RTimeLastPlayed), 0)
+		}
+
+		return playtime, nil
+	}
+
+	return nil, ErrGameNotOwned
+}
+
+// ProfileItem is a cosmetic community item equipped on a users profile.
+type ProfileItem struct {
+	CommunityItemID string        `json:"communityitemid"`
+	ImageSmall      string        `json:"image_small"`
+	ImageLarge      string        `json:"image_large"`
+	Name            string        `json:"name"`
+	ItemTitle       string        `json:"item_title"`
+	ItemDescription string        `json:"item_description"`
+	AppID           steamid.AppID `json:"appid"`
+	ItemType        int           `json:"item_type"`
+	ItemClass       int           `json:"item_class"`
+	MovieWebm       string        `json:"movie_webm"`
+	MovieMp4        string        `json:"movie_mp4"`
+	MovieWebmSmall  string        `json:"movie_webm_small"`
+	MovieMp4Small   string        `json:"movie_mp4_small"`
+	EquippedFlags   int           `json:"equipped_flags"`
+}
+
+// ProfileItemsEquipped contains the items a user has equipped on their profile. Items that are not equipped
+// are left empty.
+type ProfileItemsEquipped struct {
+	ProfileBackground     ProfileItem `json:"profile_background"`
+	MiniProfileBackground ProfileItem `json:"mini_profile_background"`
+	AvatarFrame           ProfileItem `json:"avatar_frame"`
+	AnimatedAvatar        ProfileItem `json:"animated_avatar"`
+	ProfileModifier       ProfileItem `json:"profile_modifier"`
+	SteamDeckKeyboardSkin ProfileItem `json:"steam_deck_keyboard_skin"`
+}
+
+// GetProfileItemsEquipped fetches the cosmetic items a user has equipped on their profile.
+// Results are cached per user for 5 minutes by default, see SetProfileCacheTTL.
+func GetProfileItemsEquipped(ctx context.Context, client HTTPClientHandler, sid steamid.SteamID) (*ProfileItemsEquipped, error) {
+	type response struct {
+		Response ProfileItemsEquipped `json:"response"`
+	}
+
+	key := newCacheKey(cacheKeyProfileItems, sid.String())
+
+	if items, found := getCached[ProfileItemsEquipped](ctx, cacheFrom(ctx), key); found {
+		return &items, nil
+	}
+
+	var resp response
+
+	errResp := apiRequest(ctx, client, "/IPlayerService/GetProfileItemsEquipped/v1", url.Values{
+		"steamid": []string{sid.String()},
+	}, &resp)
+	if errResp != nil {
+		return nil, errResp
+	}
+
+	cacheFrom(ctx).set(key, resp.Response, cacheTTL(ctx, profileTTL()))
+
+	return &resp.Response, nil
+}
+
+// WishlistItem is a single app on a users wishlist.
+type WishlistItem struct {
+	AppID steamid.AppID `json:"appid"`
+	// The users ordering of the item, 0 being the highest priority.
+	Priority int `json:"priority"`
+	// Unix timestamp of when the item was added to the wishlist.
+	DateAdded int `json:"date_added"`
+}
+
+// GetWishlist returns all the apps on a users wishlist. This endpoint does not include store details such as
+// release or sale status, use the storefront for those.
+// ErrProfilePrivate is returned when the wishlist is not public.
+func GetWishlist(ctx context.Context, client HTTPClientHandler, sid steamid.SteamID) ([]WishlistItem, error) {
+	type response struct {
+		Response struct {
+			Items []WishlistItem `json:"items"`
+		} `json:"response"`
+	}
+
+	var resp response
+
+	errResp := apiRequest(ctx, client, "/IWishlistService/GetWishlist/v1", url.Values{
+		"steamid": []string{sid.String()},
 	}, &resp)
 	if errResp != nil {
+		if errors.Is(errResp, ErrUnauthorized) {
+			return nil, ErrProfilePrivate
+		}
+
 		return nil, errResp
 	}
 
-	return resp.Response.Games, nil
+	return resp.Response.Items, nil
 }
 
 // Badge is a badge belonging to a user.
@@ -1296,6 +3072,37 @@ type BadgeStatus struct {
 	PlayerXpNeededCurrentLevel int     `json:"player_xp_needed_current_level"`
 }
 
+// IsGameBadge reports whether the badge is a trading card badge for an app rather than an event or meta badge.
+func (b Badge) IsGameBadge() bool {
+	return b.AppID != 0
+}
+
+// GameBadges returns the trading card badges that relate to an app.
+func (b BadgeStatus) GameBadges() []Badge {
+	var badges []Badge
+
+	for _, badge := range b.Badges {
+		if badge.IsGameBadge() {
+			badges = append(badges, badge)
+		}
+	}
+
+	return badges
+}
+
+// MetaBadges returns the event and account badges that do not relate to an app.
+func (b BadgeStatus) MetaBadges() []Badge {
+	var badges []Badge
+
+	for _, badge := range b.Badges {
+		if !badge.IsGameBadge() {
+			badges = append(badges, badge)
+		}
+	}
+
+	return badges
+}
+
 // GetBadges Lists all badges for a user
 // No results returned is usually due to privacy settings.
 func GetBadges(ctx context.Context, client HTTPClientHandler, sid steamid.SteamID) (*BadgeStatus, error) {
@@ -1345,26 +3152,186 @@ func GetCommunityBadgeProgress(ctx context.Context, client HTTPClientHandler, si
 	return resp.Response.Quests, nil
 }
 
+// AssetDescription is a single line of an assets description.
+type AssetDescription struct {
+	Type  string `json:"type" mapstructure:"type"`
+	Value string `json:"value" mapstructure:"value"`
+	Color string `json:"color,omitempty" mapstructure:"color"`
+	Label string `json:"label,omitempty" mapstructure:"label"`
+}
+
+// AssetAction is a link associated with an asset, such as the wiki page or inspect link.
+type AssetAction struct {
+	Name string `json:"name" mapstructure:"name"`
+	Link string `json:"link" mapstructure:"link"`
+}
+
+// AssetAppData contains the game specific data attached to an asset.
+type AssetAppData struct {
+	DefIndex string `json:"def_index" mapstructure:"def_index"`
+	Quality  string `json:"quality" mapstructure:"quality"`
+}
+
 // Asset is an in game asset.
 type Asset struct {
-	// Descriptions []struct {
-	//	Name  string `json:"name" mapstructure:"name"`
-	//	Value string `json:"value" mapstructure:"value"`
-	//	Color string `json:"color,omitempty" mapstructure:"color"`
-	// } `json:"descriptions" mapstructure:"descriptions,omitempty"`
-	Descriptions    any    `json:"descriptions" mapstructure:"descriptions"`
-	FraudWarnings   any    `json:"fraudwarnings" mapstructure:"fraudwarnings"`
-	Tradable        string `json:"tradable" mapstructure:"tradable"`
-	BackgroundColor string `json:"background_color" mapstructure:"background_color"`
-	IconURL         string `json:"icon_url" mapstructure:"icon_url"`
-	Name            string `json:"name" mapstructure:"name"`
-	Type            string `json:"type" mapstructure:"type"`
-	NameColor       string `json:"name_color" mapstructure:"name_color"`
-	Actions         any    `json:"actions" mapstructure:"actions"`
-}
-
-// GetAssetClassInfo gets info on items/assets.
+	ClassID         string             `json:"classid" mapstructure:"classid"`
+	Descriptions    []AssetDescription `json:"descriptions" mapstructure:"descriptions"`
+	FraudWarnings   []string           `json:"fraudwarnings" mapstructure:"fraudwarnings"`
+	Tradable        string             `json:"tradable" mapstructure:"tradable"`
+	Marketable      string             `json:"marketable" mapstructure:"marketable"`
+	Commodity       string             `json:"commodity" mapstructure:"commodity"`
+	BackgroundColor string             `json:"background_color" mapstructure:"background_color"`
+	IconURL         string             `json:"icon_url" mapstructure:"icon_url"`
+	IconURLLarge    string             `json:"icon_url_large" mapstructure:"icon_url_large"`
+	Name            string             `json:"name" mapstructure:"name"`
+	MarketName      string             `json:"market_name" mapstructure:"market_name"`
+	MarketHashName  string             `json:"market_hash_name" mapstructure:"market_hash_name"`
+	Type            string             `json:"type" mapstructure:"type"`
+	NameColor       string             `json:"name_color" mapstructure:"name_color"`
+	Actions         []AssetAction      `json:"actions" mapstructure:"actions"`
+	AppData         AssetAppData       `json:"app_data" mapstructure:"app_data"`
+}
+
+// decodeAsset decodes a single entry of the GetAssetClassInfo result.
+func decodeAsset(val any) (Asset, error) {
+	var asset Asset
+
+	decoder, errDecoder := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		DecodeHook: assetDecodeHook,
+		Result:     &asset,
+	})
+	if errDecoder != nil {
+		return asset, errors.Wrap(errDecoder, "Failed to create decoder")
+	}
+
+	if errDecode := decoder.Decode(val); errDecode != nil {
+		return asset, errors.Wrap(errDecode, "Failed to decode mapstructure")
+	}
+
+	return asset, nil
+}
+
+// assetDecodeHook converts the quirks of the asset class info response into shapes mapstructure can decode.
+// Steam sends lists as objects keyed by their index, eg: {"0": {...}, "1": {...}}, and missing values as "".
+func assetDecodeHook(_ reflect.Type, target reflect.Type, data any) (any, error) {
+	switch value := data.(type) {
+	case string:
+		if value == "" && target.Kind() != reflect.String {
+			return reflect.Zero(target).Interface(), nil
+		}
+	case map[string]any:
+		if target.Kind() != reflect.Slice {
+			return data, nil
+		}
+
+		indexes := make([]int, 0, len(value))
+
+		for key := range value {
+			index, errIndex := strconv.Atoi(key)
+			if errIndex != nil {
+				return nil, errors.Wrapf(errIndex, "Invalid list index: %s", key)
+			}
+
+			indexes = append(indexes, index)
+		}
+
+		sort.Ints(indexes)
+
+		values := make([]any, len(indexes))
+		for i, index := range indexes {
+			values[i] = value[strconv.Itoa(index)]
+		}
+
+		return values, nil
+	}
+
+	return data, nil
+}
+
+// GetAssetClassInfo gets info on items/assets. Localized strings are returned using the language set with
+// WithLang, or SetLang when not set on the context.
+// Results are cached per app, class and language, so only classes not already cached are requested. Assets are
+// returned in the order of classIDs, any classes steam did not return are omitted. Use GetAssetClassInfoMap to
+// find out which classes were omitted.
 func GetAssetClassInfo(ctx context.Context, client HTTPClientHandler, appID steamid.AppID, classIDs []int) ([]Asset, error) {
+	found, errFound := getAssetClassInfoCached(ctx, client, appID, classIDs)
+	if errFound != nil {
+		return nil, errFound
+	}
+
+	assets := make([]Asset, 0, len(classIDs))
+
+	for _, classID := range classIDs {
+		if asset, ok := found[strconv.Itoa(classID)]; ok {
+			assets = append(assets, asset)
+		}
+	}
+
+	return assets, nil
+}
+
+// GetAssetClassInfoMap is the same as GetAssetClassInfo, but returns the assets keyed by class id along with the
+// requested class ids steam returned no info for, in the order they were requested.
+func GetAssetClassInfoMap(ctx context.Context, client HTTPClientHandler, appID steamid.AppID, classIDs []int) (map[int]Asset, []int, error) {
+	found, errFound := getAssetClassInfoCached(ctx, client, appID, classIDs)
+	if errFound != nil {
+		return nil, nil, errFound
+	}
+
+	assets := make(map[int]Asset, len(classIDs))
+
+	var missing []int
+
+	for _, classID := range classIDs {
+		if _, seen := assets[classID]; seen || slices.Contains(missing, classID) {
+			continue
+		}
+
+		if asset, ok := found[strconv.Itoa(classID)]; ok {
+			assets[classID] = asset
+		} else {
+			missing = append(missing, classID)
+		}
+	}
+
+	return assets, missing, nil
+}
+
+// getAssetClassInfoCached returns the assets for the class ids keyed by their ClassID, only requesting the
+// classes which are not already cached.
+func getAssetClassInfoCached(ctx context.Context, client HTTPClientHandler, appID steamid.AppID, classIDs []int) (map[string]Asset, error) {
+	userLang := langFrom(ctx)
+	found := make(map[string]Asset, len(classIDs))
+
+	var missing []int
+
+	for _, classID := range classIDs {
+		if asset, ok := getCached[Asset](ctx, cacheFrom(ctx), newCacheKey(cacheKeyAssetClass, appID, classID, userLang)); ok {
+			found[asset.ClassID] = asset
+		} else {
+			missing = append(missing, classID)
+		}
+	}
+
+	if len(missing) > 0 {
+		fetched, errFetch := getAssetClassInfo(ctx, client, appID, userLang, missing)
+		if errFetch != nil {
+			return nil, errFetch
+		}
+
+		ttl := cacheTTL(ctx, defaultCacheTTL)
+
+		for _, asset := range fetched {
+			found[asset.ClassID] = asset
+			cacheFrom(ctx).set(newCacheKey(cacheKeyAssetClass, appID, asset.ClassID, userLang), asset, ttl)
+		}
+	}
+
+	return found, nil
+}
+
+// getAssetClassInfo performs the GetAssetClassInfo request for the class ids.
+func getAssetClassInfo(ctx context.Context, client HTTPClientHandler, appID steamid.AppID, userLang string, classIDs []int) ([]Asset, error) {
 	type response struct {
 		Result map[string]any `json:"result"`
 	}
@@ -1375,7 +3342,7 @@ func GetAssetClassInfo(ctx context.Context, client HTTPClientHandler, appID stea
 		// Not all strings have been translated to every language. If a language does not have a string,
 		// the English string will be returned instead. If this parameter is omitted the string token will
 		// be returned for the strings.
-		"language":    []string{lang},
+		"language":    []string{userLang},
 		"class_count": []string{fmt.Sprintf("%d", len(classIDs))},
 	}
 
@@ -1409,12 +3376,12 @@ func GetAssetClassInfo(ctx context.Context, client HTTPClientHandler, appID stea
 	index := 0
 
 	for _, val := range resp.Result {
-		var s Asset
-		if errDecode := mapstructure.Decode(val, &s); errDecode != nil {
-			return nil, errors.Wrap(errDecode, "Failed to decode mapstructure")
+		asset, errDecode := decodeAsset(val)
+		if errDecode != nil {
+			return nil, errDecode
 		}
 
-		assets[index] = s
+		assets[index] = asset
 
 		index++
 	}
@@ -1423,51 +3390,128 @@ func GetAssetClassInfo(ctx context.Context, client HTTPClientHandler, appID stea
 }
 
 var (
-	groupMemberRx = regexp.MustCompile(`<steamID64>(\d+)</steamID64>`)
-	errInvalidID  = errors.New("got invalid id")
+	errInvalidID = errors.New("got invalid id")
+	// groupLimiter throttles requests to the group xml endpoint separately from the rest of the api.
+	groupLimiter = rate.NewLimiter(defaultGroupRequestRate, 1) //nolint:gochecknoglobals
 )
 
-// GetGroupMembers fetches all steamids that belong to a steam group.
+// defaultGroupRequestRate allows one group xml request every 2 seconds.
+const defaultGroupRequestRate = rate.Limit(0.5)
+
+// SetGroupRequestRate sets the maximum requests per second made to the steam community group xml endpoint used by
+// GetGroupMembers. This is independent of any rate limiting done by the HTTPClientHandler. A value <= 0 restores
+// the default of 0.5.
+func SetGroupRequestRate(rps float64) {
+	limit := rate.Limit(rps)
+	if rps <= 0 {
+		limit = defaultGroupRequestRate
+	}
+
+	groupLimiter.SetLimit(limit)
+}
+
+// groupMemberList is a single page of the steam community group members xml.
+type groupMemberList struct {
+	MemberCount int      `xml:"memberCount"`
+	TotalPages  int      `xml:"totalPages"`
+	CurrentPage int      `xml:"currentPage"`
+	Members     []string `xml:"members>steamID64"`
+}
+
+// GetGroupMembers fetches all steamids that belong to a steam group. Every page of members is fetched.
+//
+// Steam sometimes serves partial pages under load. When the number of members parsed does not match the
+// memberCount reported by steam, the members that were found are returned along with an *IncompleteGroupError
+// which callers can inspect to decide if they want to retry.
+//
 // WARN: This does not use the actual steam api and instead fetches and parses the groups XML data. This endpoint
-// is far more heavily rate limited by steam.
+// is far more heavily rate limited by steam, so requests are throttled separately, see SetGroupRequestRate.
 func GetGroupMembers(ctx context.Context, client HTTPClientHandler, groupID steamid.SteamID) (steamid.Collection, error) {
 	if !groupID.Valid() {
 		return nil, errors.New("Invalid steam group ID")
 	}
 
-	lCtx, cancel := context.WithTimeout(ctx, defaultRequestTimeout)
+	var (
+		found    steamid.Collection
+		expected int
+	)
+
+	for page, totalPages := 1, 1; page <= totalPages; page++ {
+		if errWait := groupLimiter.Wait(ctx); errWait != nil {
+			return nil, errors.Wrap(errWait, "Failed to wait for group rate limit")
+		}
+
+		body, errFetch := fetchRaw(ctx, client,
+			fmt.Sprintf("https://steamcommunity.com/gid/%d/memberslistxml/?xml=1&p=%d", groupID.Int64(), page))
+		if errFetch != nil {
+			return nil, errFetch
+		}
+
+		var list groupMemberList
+		if errUnmarshal := xml.Unmarshal(body, &list); errUnmarshal != nil {
+			return nil, errors.Wrap(errUnmarshal, "Failed to decode group members")
+		}
+
+		for _, member := range list.Members {
+			sid := steamid.New(member)
+			if !sid.Valid() {
+				return nil, fmt.Errorf("%w: %s", errInvalidID, member)
+			}
+
+			found = append(found, sid)
+		}
+
+		if list.TotalPages > 0 {
+			totalPages = list.TotalPages
+		}
+
+		expected = list.MemberCount
+	}
+
+	if len(found) != expected {
+		return found, &IncompleteGroupError{GroupID: groupID, Expected: expected, Actual: len(found)}
+	}
+
+	return found, nil
+}
+
+// fetchRaw performs a plain GET request against a non-api url and returns the response body.
+func fetchRaw(ctx context.Context, client HTTPClientHandler, rawURL string) ([]byte, error) {
+	if errAcquire := lifecycle.acquire(); errAcquire != nil {
+		return nil, errAcquire
+	}
+
+	defer lifecycle.release()
+
+	lCtx, cancel := requestContext(ctx, defaultRequestTimeout)
 	defer cancel()
 
-	req, reqErr := http.NewRequestWithContext(lCtx, http.MethodGet,
-		fmt.Sprintf("https://steamcommunity.com/gid/%d/memberslistxml/?xml=1", groupID.Int64()), nil)
+	if lCtx.Err() != nil {
+		return nil, errors.Wrap(lCtx.Err(), "Request cancelled")
+	}
+
+	req, reqErr := http.NewRequestWithContext(lCtx, http.MethodGet, rawURL, nil)
 	if reqErr != nil {
 		return nil, errors.Wrapf(reqErr, "Failed to create request")
 	}
 
-	resp, respErr := client.Do(req)
+	resp, respErr := resolveClient(ctx, client).Do(req)
 	if respErr != nil {
-		return nil, errors.Wrapf(reqErr, "Failed to perform request")
+		return nil, errors.Wrapf(wrapRequestError(ctx, respErr), "Failed to perform request")
 	}
 
 	defer func() {
 		_ = resp.Body.Close()
 	}()
 
-	body, bodyErr := io.ReadAll(resp.Body)
-	if bodyErr != nil {
-		return nil, errors.Wrapf(reqErr, "Failed to read response body")
+	if resp.StatusCode != http.StatusOK {
+		return nil, &StatusError{StatusCode: resp.StatusCode, Path: req.URL.Path}
 	}
 
-	var found steamid.Collection
-
-	for _, match := range groupMemberRx.FindAllStringSubmatch(string(body), -1) {
-		sid := steamid.New(match[1])
-		if !sid.Valid() {
-			return nil, fmt.Errorf("%w: %s", errInvalidID, match[1])
-		}
-
-		found = append(found, sid)
+	body, bodyErr := io.ReadAll(newLimitedBody(resp.Body))
+	if bodyErr != nil {
+		return nil, errors.Wrapf(bodyErr, "Failed to read response body")
 	}
 
-	return found, nil
+	return body, nil
 }
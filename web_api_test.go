@@ -2,9 +2,14 @@ package steamweb_test
 
 import (
 	"context"
+	"fmt"
+	"math"
 	"net"
 	"net/http"
 	"os"
+	"strconv"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -60,6 +65,161 @@ func TestGetAppList(t *testing.T) {
 	require.Greater(t, len(apps), 5000)
 }
 
+func TestGetAppListByType(t *testing.T) {
+	apps, err := steamweb.GetAppListByType(context.Background(), testClient, steamweb.AppTypeDLC)
+	require.NoError(t, err)
+	require.Greater(t, len(apps), 5000)
+
+	for _, app := range apps {
+		require.Equal(t, steamweb.AppTypeDLC, app.Type)
+	}
+}
+
+func TestGetAppDetails(t *testing.T) {
+	details, err := steamweb.GetAppDetails(context.Background(), testClient, testAppTF2)
+	require.NoError(t, err)
+	require.Equal(t, "Team Fortress 2", details.Name)
+	require.True(t, details.IsFree)
+
+	_, errMissing := steamweb.GetAppDetails(context.Background(), testClient, 1)
+	require.ErrorIs(t, errMissing, steamweb.ErrInvalidResponse)
+}
+
+func TestGetFeatured(t *testing.T) {
+	featured, err := steamweb.GetFeatured(context.Background(), testClient, &steamweb.FeaturedOptions{CountryCode: "US"})
+	require.NoError(t, err)
+	require.Positive(t, len(featured.FeaturedWin))
+}
+
+func TestGetAppDLC(t *testing.T) {
+	dlc, err := steamweb.GetAppDLC(context.Background(), testClient, 620)
+	require.NoError(t, err)
+	require.Positive(t, len(dlc))
+}
+
+func TestFindApps(t *testing.T) {
+	app, found, err := steamweb.AppByID(context.Background(), testClient, testAppTF2)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, "Team Fortress 2", app.Name)
+
+	apps, errFind := steamweb.FindApps(context.Background(), testClient, "team fortress")
+	require.NoError(t, errFind)
+	require.Positive(t, len(apps))
+}
+
+func TestMockClient(t *testing.T) {
+	client := steamweb.NewMockClient(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/IPlayerService/GetSteamLevel/v1/", r.URL.Path)
+		_, _ = w.Write([]byte(`{"response":{"player_level":42}}`))
+	}))
+
+	level, err := steamweb.GetSteamLevel(context.Background(), client, testIDSquirrelly)
+	require.NoError(t, err)
+	require.Equal(t, 42, level)
+	require.Len(t, client.Requests(), 1)
+
+	client.Reset()
+	require.Empty(t, client.Requests())
+}
+
+func TestMockClientNoKey(t *testing.T) {
+	key := steamweb.Key()
+	t.Cleanup(func() { _ = steamweb.SetKey(key) })
+	require.NoError(t, steamweb.SetKey(""))
+
+	client := steamweb.NewMockClient(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Retry-After", "5")
+		w.WriteHeader(http.StatusServiceUnavailable)
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	_, err := steamweb.GetSteamLevel(context.Background(), client, testIDSquirrelly)
+	require.ErrorIs(t, err, steamweb.ErrServiceUnavailable)
+
+	wrapped := struct{ *steamweb.MockClient }{client}
+
+	_, errWrapped := steamweb.GetSteamLevel(context.Background(), wrapped, testIDSquirrelly)
+	require.ErrorIs(t, errWrapped, steamweb.ErrServiceUnavailable)
+
+	_, errNoKey := steamweb.GetSteamLevel(context.Background(), &http.Client{}, testIDSquirrelly)
+	require.ErrorIs(t, errNoKey, steamweb.ErrNoAPIKey)
+}
+
+func TestWithCacheTTL(t *testing.T) {
+	client := steamweb.NewMockClient(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"result":{"status":1,"items_game_url":"https://example.com/items_game.txt"}}`))
+	}))
+
+	ctx := steamweb.WithCacheTTL(steamweb.WithCacheBypass(context.Background()), time.Hour*48)
+
+	_, err := steamweb.GetSchemaURL(ctx, client, 999999)
+	require.NoError(t, err)
+
+	found := false
+
+	for _, entry := range steamweb.CacheEntries() {
+		if entry.Key == "schemaurl:999999" {
+			found = true
+
+			require.Equal(t, time.Hour*48, entry.TTL)
+		}
+	}
+
+	require.True(t, found)
+}
+
+func TestGetPlayerProfile(t *testing.T) {
+	client := steamweb.NewMockClient(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/ISteamUser/GetPlayerSummaries/v0002/":
+			_, _ = w.Write([]byte(`{"response":{"players":[{"steamid":"76561197961279983","personaname":"test"}]}}`))
+		case "/IPlayerService/GetSteamLevel/v1/":
+			_, _ = w.Write([]byte(`{"response":{"player_level":10}}`))
+		case "/IPlayerService/GetOwnedGames/v1":
+			_, _ = w.Write([]byte(`{"response":{}}`))
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+
+	profile, err := steamweb.GetPlayerProfile(context.Background(), client, testIDSquirrelly,
+		steamweb.ProfileOptions{Level: true, OwnedGames: true, Bans: true})
+	require.NoError(t, err)
+	require.Equal(t, "test", profile.Summary.PersonaName)
+	require.Equal(t, 10, profile.Level)
+	require.Nil(t, profile.Badges)
+	require.ErrorIs(t, profile.Errors[steamweb.ProfileSectionOwnedGames], steamweb.ErrProfilePrivate)
+	require.Error(t, profile.Errors[steamweb.ProfileSectionBans])
+	require.Len(t, profile.Errors, 2)
+}
+
+func TestBlockingClient(t *testing.T) {
+	client := steamweb.NewBlockingClient()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*50)
+	defer cancel()
+
+	_, err := steamweb.GetSteamLevel(ctx, client, testIDSquirrelly)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.Len(t, client.Requests(), 1)
+}
+
+func TestDefaultClient(t *testing.T) {
+	steamweb.SetDefaultClient(testClient)
+	defer steamweb.SetDefaultClient(nil)
+
+	level, err := steamweb.GetSteamLevel(context.Background(), nil, testIDSquirrelly)
+	if err != nil && errors.Is(err, steamweb.ErrServiceUnavailable) {
+		t.Skipf("Service not available currently")
+
+		return
+	}
+
+	require.NoError(t, err)
+	require.Positive(t, level)
+}
+
 func TestPlayerSummaries(t *testing.T) {
 	ids := steamid.Collection{steamid.New(76561198132612090), testIDSquirrelly, steamid.New(76561197960435530)}
 	p, err := steamweb.PlayerSummaries(context.Background(), testClient, ids)
@@ -67,6 +227,29 @@ func TestPlayerSummaries(t *testing.T) {
 	require.Equal(t, len(ids), len(p))
 }
 
+func TestSteamIDCountErrors(t *testing.T) {
+	_, errFew := steamweb.PlayerSummaries(context.Background(), testClient, nil)
+	require.ErrorIs(t, errFew, steamweb.ErrTooFewIDs)
+
+	tooMany := make(steamid.Collection, 101)
+	for i := range tooMany {
+		tooMany[i] = steamid.New(76561197960287930 + int64(i))
+	}
+
+	_, errMany := steamweb.GetPlayerBans(context.Background(), testClient, tooMany)
+	require.ErrorIs(t, errMany, steamweb.ErrTooManyIDs)
+
+	_, errLink := steamweb.GetPlayerLinkDetails(context.Background(), testClient, tooMany)
+	require.ErrorIs(t, errLink, steamweb.ErrTooManyIDs)
+}
+
+func TestGetPlayerLinkDetails(t *testing.T) {
+	ids := steamid.Collection{steamid.New(76561198132612090), testIDSquirrelly}
+	details, err := steamweb.GetPlayerLinkDetails(context.Background(), testClient, ids)
+	require.NoError(t, err)
+	require.Len(t, details, len(ids))
+}
+
 func TestGetUserGroupList(t *testing.T) {
 	groupIDs, err := steamweb.GetUserGroupList(context.Background(), testClient, testIDSquirrelly)
 	require.NoError(t, err)
@@ -86,10 +269,33 @@ func TestGetPlayerBans(t *testing.T) {
 	require.Equal(t, len(ids), len(bans))
 }
 
+func TestGetPlayerBansMap(t *testing.T) {
+	ids := steamid.Collection{steamid.New(76561198132612090), testIDSquirrelly, steamid.New(76561197960435530), testIDSquirrelly}
+	bans, err := steamweb.GetPlayerBansMap(context.Background(), testClient, ids)
+	require.NoError(t, err)
+	require.Len(t, bans, 3)
+	require.Contains(t, bans, testIDSquirrelly)
+}
+
+func TestPlayerBanReport(t *testing.T) {
+	ids := steamid.Collection{steamid.New(76561198132612090), testIDSquirrelly, steamid.New(76561197960435530)}
+	reports, err := steamweb.PlayerBanReport(context.Background(), testClient, ids)
+	require.NoError(t, err)
+	require.Len(t, reports, 3)
+
+	for _, report := range reports {
+		require.NotEmpty(t, report.Summary)
+		require.NotEmpty(t, report.SteamID3)
+	}
+}
+
 func TestGetServersAtAddress(t *testing.T) {
 	servers, err := steamweb.GetServersAtAddress(context.Background(), testClient, net.ParseIP("51.222.245.142"))
 	require.NoError(t, err)
 	require.Positive(t, len(servers))
+
+	_, errIPv6 := steamweb.GetServersAtAddress(context.Background(), testClient, net.ParseIP("2001:db8::1"))
+	require.ErrorIs(t, errIPv6, steamweb.ErrIPv6Unsupported)
 }
 
 func TestGetServerList(t *testing.T) {
@@ -98,6 +304,41 @@ func TestGetServerList(t *testing.T) {
 	require.Positive(t, len(servers))
 }
 
+func TestBuildServerFilter(t *testing.T) {
+	filter, err := steamweb.BuildServerFilter(map[string]string{
+		"map":        "cp_badlands",
+		"appid":      "440",
+		"gametype":   "valve,payload, hidden",
+		"name_match": "*my server*",
+	})
+	require.NoError(t, err)
+	require.Equal(t, `\appid\440\gametype\valve,payload, hidden\map\cp_badlands\name_match\*my server*`, filter)
+
+	empty, errEmpty := steamweb.BuildServerFilter(nil)
+	require.NoError(t, errEmpty)
+	require.Empty(t, empty)
+
+	_, errValue := steamweb.BuildServerFilter(map[string]string{"map": `cp_\badlands`})
+	require.ErrorIs(t, errValue, steamweb.ErrInvalidFilter)
+
+	_, errKey := steamweb.BuildServerFilter(map[string]string{`ma\p`: "cp_badlands"})
+	require.ErrorIs(t, errKey, steamweb.ErrInvalidFilter)
+
+	_, errList := steamweb.GetServerList(context.Background(), testClient, map[string]string{"map": `a\b`})
+	require.ErrorIs(t, errList, steamweb.ErrInvalidFilter)
+}
+
+func TestGetServerListByRegion(t *testing.T) {
+	servers, err := steamweb.GetServerListByRegion(context.Background(), testClient, map[string]string{"appid": "440"},
+		steamweb.RegionUSEast, steamweb.RegionEurope)
+	require.NoError(t, err)
+	require.Positive(t, len(servers))
+
+	for _, server := range servers {
+		require.Contains(t, []int{int(steamweb.RegionUSEast), int(steamweb.RegionEurope)}, server.Region)
+	}
+}
+
 func TestUpToDateCheck(t *testing.T) {
 	respOld, err := steamweb.UpToDateCheck(context.Background(), testClient, 440, 100)
 	require.NoError(t, err)
@@ -124,12 +365,166 @@ func TestGetNewsForApp(t *testing.T) {
 	require.Len(t, newsItemsCount, int(opts.Count))
 }
 
+func TestGetNewsForAppFullContent(t *testing.T) {
+	client := steamweb.NewMockClient(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.True(t, r.URL.Query().Has("maxlength"))
+		require.Equal(t, "0", r.URL.Query().Get("maxlength"))
+		_, _ = w.Write([]byte(`{"appnews":{"appid":440,"newsitems":[{"gid":"1","contents":"full"}],"count":1}}`))
+	}))
+
+	newsItems, err := steamweb.GetNewsForApp(context.Background(), client, testAppTF2,
+		&steamweb.GetNewsForAppOptions{MaxLength: 100, FullContent: true})
+	require.NoError(t, err)
+	require.Len(t, newsItems, 1)
+}
+
+func TestGetNewsForApps(t *testing.T) {
+	appIDs := []steamid.AppID{testAppTF2, 730}
+	news, err := steamweb.GetNewsForApps(context.Background(), testClient, appIDs, &steamweb.GetNewsForAppOptions{Count: 2})
+	require.NoError(t, err)
+	require.Len(t, news, len(appIDs))
+
+	for _, appID := range appIDs {
+		require.Len(t, news[appID], 2)
+	}
+}
+
 func TestGetNumberOfCurrentPlayers(t *testing.T) {
 	players, err := steamweb.GetNumberOfCurrentPlayers(context.Background(), testClient, 440)
 	require.NoError(t, err)
 	require.Greater(t, players, 2000)
 }
 
+func TestStartPlayerCountSampler(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	samples := steamweb.StartPlayerCountSampler(ctx, testClient, testAppTF2, time.Second)
+
+	sample := <-samples
+	require.NoError(t, sample.Err)
+	require.Positive(t, sample.Count)
+
+	cancel()
+
+	for range samples { //nolint:revive
+	}
+}
+
+func TestPlayerCountRing(t *testing.T) {
+	ring := steamweb.NewPlayerCountRing(3)
+	start := time.Now()
+
+	require.Empty(t, ring.Samples(testAppTF2))
+
+	for i := range 5 {
+		ring.Record(testAppTF2, i, start.Add(time.Duration(i)*time.Minute))
+	}
+
+	ring.Record(730, 100, start)
+
+	samples := ring.Samples(testAppTF2)
+	require.Len(t, samples, 3)
+	require.Equal(t, []int{2, 3, 4}, []int{samples[0].Count, samples[1].Count, samples[2].Count})
+	require.Len(t, ring.Samples(730), 1)
+}
+
+func TestRecordPlayerCounts(t *testing.T) {
+	client := steamweb.NewMockClient(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"response":{"player_count":5,"result":1}}`))
+	}))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ring := steamweb.NewPlayerCountRing(10)
+	done := steamweb.RecordPlayerCounts(ctx, client, testAppTF2, time.Millisecond*10, ring)
+
+	require.Eventually(t, func() bool {
+		return len(ring.Samples(testAppTF2)) >= 2
+	}, time.Second, time.Millisecond*5)
+
+	cancel()
+	<-done
+
+	require.Equal(t, 5, ring.Samples(testAppTF2)[0].Count)
+}
+
+func TestStartAppListRefresh(t *testing.T) {
+	t.Cleanup(steamweb.ClearCache)
+	steamweb.ClearCache()
+
+	// Each refresh makes up to 2 attempts, so failing 3 requests in a row makes a refresh fail outright and
+	// exercises the backoff of the refresh loop rather than only the request retries.
+	steamweb.SetRetryPolicy(&steamweb.RetryPolicy{MaxRetries: 1, BaseDelay: time.Millisecond})
+	t.Cleanup(func() { steamweb.SetRetryPolicy(nil) })
+
+	var (
+		mu        sync.Mutex
+		requests  int
+		recovered bool
+	)
+
+	client := steamweb.NewMockClient(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		requests++
+
+		if requests >= 2 && requests <= 4 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+
+			return
+		}
+
+		recovered = requests > 4
+
+		_, _ = w.Write([]byte(`{"applist":{"apps":[{"appid":440,"name":"Team Fortress 2"}]}}`))
+	}))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	done := steamweb.StartAppListRefresh(ctx, client, time.Millisecond*10)
+
+	require.Eventually(t, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+
+		return recovered
+	}, time.Second, time.Millisecond*5)
+
+	cancel()
+	<-done
+
+	_, found := steamweb.AppListAge()
+	require.True(t, found)
+
+	app, found, err := steamweb.AppByID(context.Background(), client, 440)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, "Team Fortress 2", app.Name)
+}
+
+func TestGetCSGOStats(t *testing.T) {
+	client := steamweb.NewMockClient(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "730", r.URL.Query().Get("appid"))
+		_, _ = w.Write([]byte(`{"playerstats":{"steamID":"76561197961279983","gameName":"ValveTestApp260","stats":[
+			{"name":"total_kills","value":300},{"name":"total_deaths","value":150},
+			{"name":"total_matches_won","value":25},{"name":"total_matches_played","value":100},
+			{"name":"total_shots_fired","value":1000},{"name":"total_shots_hit","value":250},
+			{"name":"last_match_kills","value":20}]}}`))
+	}))
+
+	stats, err := steamweb.GetCSGOStats(context.Background(), client, testIDSquirrelly)
+	require.NoError(t, err)
+	require.Equal(t, 300, stats.Kills)
+	require.InDelta(t, 2.0, stats.KD, 0.001)
+	require.InDelta(t, 25.0, stats.WinRate, 0.001)
+	require.InDelta(t, 25.0, stats.Accuracy, 0.001)
+	require.Equal(t, 20, stats.Raw["last_match_kills"])
+}
+
 func TestGetUserStatsForGame(t *testing.T) {
 	s, err := steamweb.GetUserStatsForGame(context.Background(), testClient, testIDSquirrelly, 440)
 	require.NoError(t, err)
@@ -141,6 +536,65 @@ func TestGetUserStatsForGame(t *testing.T) {
 	require.Error(t, err2)
 }
 
+func TestGetUserStatsForGameError(t *testing.T) {
+	client := steamweb.NewMockClient(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get("appid") {
+		case "440":
+			_, _ = fmt.Fprint(w, `{"playerstats":{"error":"Profile is not public","success":false}}`)
+		case "570":
+			_, _ = fmt.Fprint(w, `{"playerstats":{"error":"Requested app has no stats","success":false}}`)
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+
+	_, errPrivate := steamweb.GetUserStatsForGame(context.Background(), client, testIDSquirrelly, 440)
+	require.ErrorIs(t, errPrivate, steamweb.ErrProfilePrivate)
+
+	var statsErr *steamweb.PlayerStatsError
+
+	_, errNoStats := steamweb.GetUserStatsForGame(context.Background(), client, testIDSquirrelly, 570)
+	require.ErrorIs(t, errNoStats, steamweb.ErrInvalidResponse)
+	require.ErrorAs(t, errNoStats, &statsErr)
+	require.Equal(t, "Requested app has no stats", statsErr.Message)
+	require.Equal(t, steamid.AppID(570), statsErr.AppID)
+
+	_, errServer := steamweb.GetUserStatsForGame(context.Background(), client, testIDSquirrelly, 730)
+	require.ErrorAs(t, errServer, &statsErr)
+
+	var statusErr *steamweb.StatusError
+
+	require.ErrorAs(t, errServer, &statusErr)
+	require.Equal(t, http.StatusInternalServerError, statusErr.StatusCode)
+}
+
+func TestGetGameStatsSchema(t *testing.T) {
+	schema, err := steamweb.GetGameStatsSchema(context.Background(), testClient, testAppTF2)
+	require.NoError(t, err)
+	require.Positive(t, len(schema.AvailableGameStats.Achievements))
+}
+
+func TestGetUserStatsDetailed(t *testing.T) {
+	stats, err := steamweb.GetUserStatsDetailed(context.Background(), testClient, testIDSquirrelly, testAppTF2)
+	require.NoError(t, err)
+	require.Positive(t, len(stats.Achievements))
+
+	for _, achievement := range stats.Achievements {
+		require.NotEmpty(t, achievement.DisplayName)
+	}
+}
+
+func TestGetPlayerAchievementCompletion(t *testing.T) {
+	completion, err := steamweb.GetPlayerAchievementCompletion(context.Background(), testClient, testIDSquirrelly, testAppTF2)
+	require.NoError(t, err)
+	require.Positive(t, completion.Total)
+	require.Equal(t, completion.Total, completion.Unlocked+len(completion.Locked))
+
+	for i := 1; i < len(completion.Locked); i++ {
+		require.GreaterOrEqual(t, completion.Locked[i-1].GlobalPercent, completion.Locked[i].GlobalPercent)
+	}
+}
+
 func TestGetPlayerItems(t *testing.T) {
 	_, backpackSlots, err := steamweb.GetPlayerItems(context.Background(), testClient, testIDSquirrelly, 440)
 	if err != nil && errors.Is(err, steamweb.ErrServiceUnavailable) {
@@ -177,20 +631,17 @@ func TestGetSchemaItems(t *testing.T) {
 	require.Greater(t, len(items), 5000)
 }
 
-func TestGetSchemaURL(t *testing.T) {
-	schemaURL, err := steamweb.GetSchemaURL(context.Background(), testClient, 440)
-	if err != nil && errors.Is(err, steamweb.ErrServiceUnavailable) {
-		t.Skipf("Service not available currently")
-
-		return
-	}
+func TestGetSchemaItemsWithOptions(t *testing.T) {
+	steamweb.ClearCache()
 
-	require.NoError(t, err)
-	require.Greater(t, len(schemaURL), 50)
-}
+	var fetched, pages int
 
-func TestGetStoreMetaData(t *testing.T) {
-	storeMetaData, err := steamweb.GetStoreMetaData(context.Background(), testClient, 440)
+	items, err := steamweb.GetSchemaItemsWithOptions(context.Background(), testClient, 440, &steamweb.GetSchemaItemsOptions{
+		OnPage: func(total int, page int) {
+			fetched = total
+			pages = page
+		},
+	})
 	if err != nil && errors.Is(err, steamweb.ErrServiceUnavailable) {
 		t.Skipf("Service not available currently")
 
@@ -198,43 +649,51 @@ func TestGetStoreMetaData(t *testing.T) {
 	}
 
 	require.NoError(t, err)
-	require.Len(t, storeMetaData.PlayerClassData, 9)
+	require.Greater(t, pages, 1)
+	require.Len(t, items, fetched)
 }
 
-func TestGetSupportedAPIList(t *testing.T) {
-	apiList, err := steamweb.GetSupportedAPIList(context.Background(), testClient)
+func TestGetSchemaItemsPartial(t *testing.T) {
+	steamweb.ClearCache()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	items, err := steamweb.GetSchemaItemsWithOptions(ctx, testClient, 440, &steamweb.GetSchemaItemsOptions{
+		OnPage: func(_ int, _ int) {
+			cancel()
+		},
+	})
 	if err != nil && errors.Is(err, steamweb.ErrServiceUnavailable) {
 		t.Skipf("Service not available currently")
 
 		return
 	}
 
-	require.NoError(t, err)
-	require.Greater(t, len(apiList), 10)
+	require.ErrorIs(t, err, context.Canceled)
+	require.NotEmpty(t, items)
 }
 
-func TestResolveVanityURL(t *testing.T) {
-	queries := []string{
-		"SQUIRRELLY",
-		"  SQUIRRELLY   ",
-		"https://steamcommunity.com/id/SQUIRRELLY",
-		"https://steamcommunity.com/profiles/76561197961279983",
+func TestFilterSchemaItems(t *testing.T) {
+	items := []steamweb.SchemaItem{
+		{DefIndex: 1, ItemSlot: "primary", UsedByClasses: []string{"Scout"}, ItemQuality: 6},
+		{DefIndex: 2, ItemSlot: "melee", UsedByClasses: []string{"Scout", "Soldier"}, ItemQuality: 6},
+		{DefIndex: 3, ItemSlot: "melee", UsedByClasses: []string{"Soldier"}, ItemQuality: 11},
+		{DefIndex: 4, ItemQuality: 6},
 	}
-	for _, s := range queries {
-		sid, err := steamweb.ResolveVanityURL(context.Background(), testClient, s)
-		if err != nil && errors.Is(err, steamweb.ErrServiceUnavailable) {
-			t.Skipf("Service not available currently")
 
-			return
-		}
+	require.Len(t, steamweb.FilterSchemaItems(items), 4)
+	require.Len(t, steamweb.FilterSchemaItems(items, steamweb.BySlot("MELEE")), 2)
+	require.Len(t, steamweb.FilterSchemaItems(items, steamweb.ByClass("scout")), 2)
+	require.Len(t, steamweb.FilterSchemaItems(items, steamweb.ByQuality(6)), 3)
 
-		require.NoError(t, err)
-		require.Equal(t, testIDSquirrelly, sid)
-	}
+	filtered := steamweb.FilterSchemaItems(items, steamweb.BySlot("melee"), steamweb.ByClass("soldier"), steamweb.ByQuality(6))
+	require.Len(t, filtered, 1)
+	require.Equal(t, 2, filtered[0].DefIndex)
 }
 
-func TestGetSteamLevel(t *testing.T) {
-	steamLevel, err := steamweb.GetSteamLevel(context.Background(), testClient, testIDSquirrelly)
+func TestGetSchemaURL(t *testing.T) {
+	schemaURL, err := steamweb.GetSchemaURL(context.Background(), testClient, 440)
 	if err != nil && errors.Is(err, steamweb.ErrServiceUnavailable) {
 		t.Skipf("Service not available currently")
 
@@ -242,11 +701,11 @@ func TestGetSteamLevel(t *testing.T) {
 	}
 
 	require.NoError(t, err)
-	require.GreaterOrEqual(t, steamLevel, 47)
+	require.Greater(t, len(schemaURL), 50)
 }
 
-func TestGetRecentlyPlayedGames(t *testing.T) {
-	recentlyPlayedGames, err := steamweb.GetRecentlyPlayedGames(context.Background(), testClient, testIDMurph)
+func TestGetItemsGameText(t *testing.T) {
+	itemsGame, err := steamweb.GetItemsGameText(context.Background(), testClient, testAppTF2)
 	if err != nil && errors.Is(err, steamweb.ErrServiceUnavailable) {
 		t.Skipf("Service not available currently")
 
@@ -254,11 +713,11 @@ func TestGetRecentlyPlayedGames(t *testing.T) {
 	}
 
 	require.NoError(t, err)
-	require.Positive(t, len(recentlyPlayedGames))
+	require.Contains(t, string(itemsGame), "items_game")
 }
 
-func TestGetOwnedGames(t *testing.T) {
-	ownedGames, err := steamweb.GetOwnedGames(context.Background(), testClient, testIDSquirrelly)
+func TestGetStoreMetaData(t *testing.T) {
+	storeMetaData, err := steamweb.GetStoreMetaData(context.Background(), testClient, 440)
 	if err != nil && errors.Is(err, steamweb.ErrServiceUnavailable) {
 		t.Skipf("Service not available currently")
 
@@ -266,24 +725,34 @@ func TestGetOwnedGames(t *testing.T) {
 	}
 
 	require.NoError(t, err)
-	require.Positive(t, len(ownedGames))
-}
+	require.Len(t, storeMetaData.PlayerClassData, 9)
 
-func TestGetBadges(t *testing.T) {
-	badges, err := steamweb.GetBadges(context.Background(), testClient, testIDDane)
-	if err != nil && errors.Is(err, steamweb.ErrServiceUnavailable) {
-		t.Skipf("Service not available currently")
+	scout, found := storeMetaData.PlayerClassByBaseName("Scout")
+	require.True(t, found)
+	require.Positive(t, scout.ID)
+}
 
-		return
+func TestStoreMetaDataPopularSchemaItems(t *testing.T) {
+	metaData := steamweb.StoreMetaData{
+		HomePageData: steamweb.HomePageData{PopularItems: []steamweb.PopularItems{
+			{DefIndex: 3, Order: 2},
+			{DefIndex: 1, Order: 1},
+			{DefIndex: 99, Order: 0},
+		}},
+		PlayerClassData: []steamweb.PlayerClassData{{ID: 1, BaseName: "scout"}},
 	}
 
-	require.NoError(t, err)
-	require.NotNil(t, badges)
-	require.Positive(t, len(badges.Badges))
+	popular := metaData.PopularSchemaItems([]steamweb.SchemaItem{{DefIndex: 1}, {DefIndex: 2}, {DefIndex: 3}})
+	require.Len(t, popular, 2)
+	require.Equal(t, 1, popular[0].DefIndex)
+	require.Equal(t, 3, popular[1].DefIndex)
+
+	_, found := metaData.PlayerClassByBaseName("medic")
+	require.False(t, found)
 }
 
-func TestGetCommunityBadgeProgress(t *testing.T) {
-	badgeProgress, err := steamweb.GetCommunityBadgeProgress(context.Background(), testClient, testIDDane)
+func TestGetSupportedAPIList(t *testing.T) {
+	apiList, err := steamweb.GetSupportedAPIList(context.Background(), testClient)
 	if err != nil && errors.Is(err, steamweb.ErrServiceUnavailable) {
 		t.Skipf("Service not available currently")
 
@@ -291,15 +760,11 @@ func TestGetCommunityBadgeProgress(t *testing.T) {
 	}
 
 	require.NoError(t, err)
-
-	// Very flaky test?
-	if badgeProgress != nil {
-		require.Positive(t, len(badgeProgress))
-	}
+	require.Greater(t, len(apiList), 10)
 }
 
-func TestGetAssetClassInfo(t *testing.T) {
-	assetClassInfo, err := steamweb.GetAssetClassInfo(context.Background(), testClient, testAppTF2, []int{195151, 16891096})
+func TestEndpointAvailable(t *testing.T) {
+	available, version, err := steamweb.EndpointAvailable(context.Background(), testClient, "ISteamUser", "GetPlayerSummaries")
 	if err != nil && errors.Is(err, steamweb.ErrServiceUnavailable) {
 		t.Skipf("Service not available currently")
 
@@ -307,16 +772,477 @@ func TestGetAssetClassInfo(t *testing.T) {
 	}
 
 	require.NoError(t, err)
-	require.NotNil(t, assetClassInfo)
+	require.True(t, available)
+	require.GreaterOrEqual(t, version, 2)
+
+	missing, _, errMissing := steamweb.EndpointAvailable(context.Background(), testClient, "ISteamUser", "NotARealMethod")
+	require.NoError(t, errMissing)
+	require.False(t, missing)
 }
 
-func TestGetGroupMembers(t *testing.T) {
-	groupMembers, err := steamweb.GetGroupMembers(context.Background(), testClient, steamid.New(103582791429521412))
-	if err != nil && errors.Is(err, steamweb.ErrServiceUnavailable) {
-		t.Skipf("Service not available currently")
+func TestGetSupportedAPIInterface(t *testing.T) {
+	t.Cleanup(steamweb.ClearCache)
+	steamweb.ClearCache()
 
-		return
-	}
+	client := steamweb.NewMockClient(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"apilist":{"interfaces":[` +
+			`{"name":"ISteamApps","methods":[{"name":"GetAppList","version":2}]},` +
+			`{"name":"ISteamUser","methods":[{"name":"GetPlayerSummaries","version":2}]}]}}`))
+	}))
+
+	iface, err := steamweb.GetSupportedAPIInterface(context.Background(), client, "isteamuser")
+	require.NoError(t, err)
+	require.Equal(t, "ISteamUser", iface.Name)
+	require.Len(t, iface.Methods, 1)
+
+	_, errMissing := steamweb.GetSupportedAPIInterface(context.Background(), client, "IDoesNotExist")
+	require.ErrorIs(t, errMissing, steamweb.ErrInterfaceNotFound)
+	require.Len(t, client.Requests(), 1)
+}
+
+func TestResolveVanityURL(t *testing.T) {
+	queries := []string{
+		"SQUIRRELLY",
+		"  SQUIRRELLY   ",
+		"https://steamcommunity.com/id/SQUIRRELLY",
+		"https://steamcommunity.com/profiles/76561197961279983",
+	}
+	for _, s := range queries {
+		sid, err := steamweb.ResolveVanityURL(context.Background(), testClient, s)
+		if err != nil && errors.Is(err, steamweb.ErrServiceUnavailable) {
+			t.Skipf("Service not available currently")
+
+			return
+		}
+
+		require.NoError(t, err)
+		require.Equal(t, testIDSquirrelly, sid)
+	}
+}
+
+func TestResolveVanityURLs(t *testing.T) {
+	queries := []string{
+		"SQUIRRELLY",
+		"https://steamcommunity.com/id/SQUIRRELLY",
+		"SQUIRRELLY",
+	}
+
+	found, errs := steamweb.ResolveVanityURLs(context.Background(), testClient, queries)
+	for _, err := range errs {
+		if errors.Is(err, steamweb.ErrServiceUnavailable) {
+			t.Skipf("Service not available currently")
+
+			return
+		}
+	}
+
+	require.Empty(t, errs)
+	require.Len(t, found, 2)
+
+	for _, sid := range found {
+		require.Equal(t, testIDSquirrelly, sid)
+	}
+}
+
+func TestResolveVanityURLsMock(t *testing.T) {
+	t.Cleanup(steamweb.ClearCache)
+	steamweb.ClearCache()
+
+	client := steamweb.NewMockClient(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("vanityurl") == "squirrelly" {
+			_, _ = w.Write([]byte(`{"response":{"steamid":"76561197961279983","success":1}}`))
+
+			return
+		}
+
+		_, _ = w.Write([]byte(`{"response":{"success":42,"message":"No match"}}`))
+	}))
+
+	found, errs := steamweb.ResolveVanityURLs(context.Background(), client, []string{"squirrelly", "unknown"})
+	require.Equal(t, map[string]steamid.SteamID{"squirrelly": testIDSquirrelly}, found)
+	require.Len(t, errs, 1)
+	require.ErrorIs(t, errs["unknown"], steamweb.ErrVanityNotFound)
+	require.Len(t, client.Requests(), 2)
+
+	// Successful lookups are cached, failed ones are not.
+	_, errsCached := steamweb.ResolveVanityURLs(context.Background(), client, []string{"squirrelly", "unknown"})
+	require.Len(t, errsCached, 1)
+	require.Len(t, client.Requests(), 3)
+}
+
+func TestResolveAny(t *testing.T) {
+	queries := []string{
+		"76561197961279983",
+		"STEAM_0:1:507127",
+		"[U:1:1014255]",
+		"SQUIRRELLY",
+		"https://steamcommunity.com/id/SQUIRRELLY",
+		"https://steamcommunity.com/profiles/76561197961279983",
+	}
+	for _, query := range queries {
+		sid, err := steamweb.ResolveAny(context.Background(), testClient, query)
+		if err != nil && errors.Is(err, steamweb.ErrServiceUnavailable) {
+			t.Skipf("Service not available currently")
+
+			return
+		}
+
+		require.NoError(t, err)
+		require.Equal(t, testIDSquirrelly, sid, query)
+	}
+}
+
+func TestGetSteamLevel(t *testing.T) {
+	steamLevel, err := steamweb.GetSteamLevel(context.Background(), testClient, testIDSquirrelly)
+	if err != nil && errors.Is(err, steamweb.ErrServiceUnavailable) {
+		t.Skipf("Service not available currently")
+
+		return
+	}
+
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, steamLevel, 47)
+}
+
+func TestGetRecentlyPlayedGames(t *testing.T) {
+	recentlyPlayedGames, err := steamweb.GetRecentlyPlayedGames(context.Background(), testClient, testIDMurph)
+	if err != nil && errors.Is(err, steamweb.ErrServiceUnavailable) {
+		t.Skipf("Service not available currently")
+
+		return
+	}
+
+	require.NoError(t, err)
+	require.Positive(t, len(recentlyPlayedGames))
+
+	var game steamweb.Game = recentlyPlayedGames[0]
+	require.Contains(t, game.HeaderImageURL(), fmt.Sprintf("%d", game.GetAppID()))
+}
+
+func TestGetOwnedGames(t *testing.T) {
+	ownedGames, err := steamweb.GetOwnedGames(context.Background(), testClient, testIDSquirrelly)
+	if err != nil && errors.Is(err, steamweb.ErrServiceUnavailable) {
+		t.Skipf("Service not available currently")
+
+		return
+	}
+
+	require.NoError(t, err)
+	require.Positive(t, len(ownedGames))
+}
+
+func TestGetOwnedGamesMulti(t *testing.T) {
+	ids := steamid.Collection{testIDSquirrelly, steamid.New(76561198132612090)}
+	results, errs := steamweb.GetOwnedGamesMulti(context.Background(), testClient, ids)
+
+	for sid, err := range errs {
+		if !errors.Is(err, steamweb.ErrProfilePrivate) {
+			require.NoError(t, err, sid.String())
+		}
+	}
+
+	require.Positive(t, len(results[testIDSquirrelly]))
+}
+
+func TestFilterValidIDs(t *testing.T) {
+	valid, invalid := steamweb.FilterValidIDs(steamid.Collection{
+		testIDSquirrelly, steamid.New(0), testIDDane, steamid.New("garbage"),
+	})
+
+	require.Equal(t, steamid.Collection{testIDSquirrelly, testIDDane}, valid)
+	require.Len(t, invalid, 2)
+}
+
+func TestChunk(t *testing.T) {
+	ids := steamid.Collection{
+		steamid.New(76561197960287930), steamid.New(76561197960287931), steamid.New(76561197960287932),
+		steamid.New(76561197960287933), steamid.New(76561197960287934),
+	}
+
+	require.Empty(t, steamweb.Chunk(nil, 2))
+	require.Equal(t, []steamid.Collection{ids[:1]}, steamweb.Chunk(ids[:1], 2))
+	require.Equal(t, []steamid.Collection{ids[:2]}, steamweb.Chunk(ids[:2], 2))
+	require.Equal(t, []steamid.Collection{ids[:2], ids[2:4], ids[4:]}, steamweb.Chunk(ids, 2))
+	require.Equal(t, []steamid.Collection{ids}, steamweb.Chunk(ids, 0))
+
+	// Appending to a chunk must not overwrite the start of the next one.
+	chunks := steamweb.Chunk(ids, 2)
+	_ = append(chunks[0], testIDDane)
+	require.Equal(t, ids[2], chunks[1][0])
+}
+
+func TestDedup(t *testing.T) {
+	require.Empty(t, steamweb.Dedup(nil))
+	require.Equal(t, steamid.Collection{testIDDane}, steamweb.Dedup(steamid.Collection{testIDDane}))
+	require.Equal(t, steamid.Collection{testIDSquirrelly, testIDDane},
+		steamweb.Dedup(steamid.Collection{testIDSquirrelly, testIDDane, testIDSquirrelly, testIDDane}))
+}
+
+func TestCommonGames(t *testing.T) {
+	results := map[steamid.SteamID][]steamweb.OwnedGame{
+		steamid.New(76561197960287930): {{AppID: 730}, {AppID: 440}, {AppID: 570}},
+		steamid.New(76561197960287931): {{AppID: 440}, {AppID: 730}, {AppID: 440}},
+		steamid.New(76561197960287932): {{AppID: 730}, {AppID: 440}, {AppID: 20}},
+	}
+
+	require.Equal(t, []steamid.AppID{440, 730}, steamweb.CommonGames(results))
+	require.Empty(t, steamweb.CommonGames(nil))
+}
+
+func TestGetRecentlyPlayedGamesMulti(t *testing.T) {
+	private := steamid.New(76561197960287931)
+	idle := steamid.New(76561197960287932)
+	client := steamweb.NewMockClient(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get("steamid") {
+		case private.String():
+			_, _ = w.Write([]byte(`{"response":{}}`))
+		case idle.String():
+			_, _ = w.Write([]byte(`{"response":{"total_count":0}}`))
+		default:
+			_, _ = w.Write([]byte(`{"response":{"total_count":1,"games":[{"appid":440,"name":"Team Fortress 2","playtime_2weeks":60}]}}`))
+		}
+	}))
+
+	results, errs := steamweb.GetRecentlyPlayedGamesMulti(context.Background(), client,
+		steamid.Collection{testIDSquirrelly, private, idle})
+	require.ErrorIs(t, errs[private], steamweb.ErrProfilePrivate)
+	require.Len(t, errs, 1)
+	require.Len(t, results[testIDSquirrelly], 1)
+	require.NotNil(t, results[idle])
+	require.Empty(t, results[idle])
+}
+
+func TestTrendingAmong(t *testing.T) {
+	results := map[steamid.SteamID][]steamweb.RecentGame{
+		steamid.New(76561197960287930): {{AppID: 730, Playtime2Weeks: 10}, {AppID: 440, Playtime2Weeks: 100}},
+		steamid.New(76561197960287931): {{AppID: 440, Playtime2Weeks: 5}, {AppID: 570, Playtime2Weeks: 500}},
+		steamid.New(76561197960287932): {{AppID: 730, Playtime2Weeks: 20}, {AppID: 20, Playtime2Weeks: 1}},
+	}
+
+	trending := steamweb.TrendingAmong(results)
+	require.Len(t, trending, 4)
+	require.Equal(t, steamid.AppID(440), trending[0].AppID)
+	require.Equal(t, 2, trending[0].Players)
+	require.Equal(t, 105, trending[0].Playtime2Weeks)
+	require.Equal(t, steamid.AppID(730), trending[1].AppID)
+	require.Equal(t, steamid.AppID(570), trending[2].AppID)
+	require.Equal(t, steamid.AppID(20), trending[3].AppID)
+	require.Empty(t, steamweb.TrendingAmong(nil))
+}
+
+func TestQueryFilesAll(t *testing.T) {
+	errStop := errors.New("stop")
+	pages := 0
+	seen := map[string]bool{}
+
+	err := steamweb.QueryFilesAll(context.Background(), testClient, steamweb.QueryFilesOptions{
+		QueryType:  steamweb.QueryRankedByPublicationDate,
+		AppID:      testAppTF2,
+		NumPerPage: 10,
+	}, func(files []steamweb.PublishedFileDetails) error {
+		pages++
+
+		for _, file := range files {
+			require.False(t, seen[file.PublishedFileID])
+			seen[file.PublishedFileID] = true
+		}
+
+		if pages == 2 {
+			return errStop
+		}
+
+		return nil
+	})
+
+	require.ErrorIs(t, err, errStop)
+	require.Len(t, seen, 20)
+}
+
+func TestSetBatchRequestRate(t *testing.T) {
+	steamweb.SetBatchRequestRate(50)
+	t.Cleanup(func() { steamweb.SetBatchRequestRate(0) })
+
+	client := steamweb.NewMockClient(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/IPublishedFileService/QueryFiles/v1" {
+			cursor, _ := strconv.Atoi(r.URL.Query().Get("cursor"))
+			_, _ = fmt.Fprintf(w, `{"response":{"total":10,"publishedfiledetails":[{"publishedfileid":"%d"}],
+				"next_cursor":"%d"}}`, cursor, min(cursor+1, 9))
+
+			return
+		}
+
+		_, _ = w.Write([]byte(`{"response":{"game_count":0,"games":[]}}`))
+	}))
+
+	// Sleep long enough for the burst to be fully replenished after any previous tests.
+	time.Sleep(time.Millisecond * 100)
+
+	start := time.Now()
+	pages := 0
+
+	errQuery := steamweb.QueryFilesAll(context.Background(), client, steamweb.QueryFilesOptions{Cursor: "0"},
+		func(_ []steamweb.PublishedFileDetails) error {
+			pages++
+
+			return nil
+		})
+	require.NoError(t, errQuery)
+	require.Equal(t, 10, pages)
+	// The first 5 pages use the burst, the remaining 5 are limited to 50 per second.
+	require.GreaterOrEqual(t, time.Since(start), time.Millisecond*80)
+
+	time.Sleep(time.Millisecond * 100)
+
+	ids := steamid.Collection{}
+	for index := range 10 {
+		ids = append(ids, steamid.New(76561197960287930+int64(index)))
+	}
+
+	start = time.Now()
+	_, errs := steamweb.GetOwnedGamesMulti(steamweb.WithCacheBypass(context.Background()), client, ids)
+	require.Empty(t, errs)
+	require.GreaterOrEqual(t, time.Since(start), time.Millisecond*80)
+}
+
+func TestGetProfileItemsEquipped(t *testing.T) {
+	items, err := steamweb.GetProfileItemsEquipped(context.Background(), testClient, testIDSquirrelly)
+	if err != nil && errors.Is(err, steamweb.ErrServiceUnavailable) {
+		t.Skipf("Service not available currently")
+
+		return
+	}
+
+	require.NoError(t, err)
+	require.NotNil(t, items)
+
+	cached, errCached := steamweb.GetProfileItemsEquipped(context.Background(), testClient, testIDSquirrelly)
+	require.NoError(t, errCached)
+	require.Equal(t, items, cached)
+}
+
+func TestGetWishlist(t *testing.T) {
+	wishlist, err := steamweb.GetWishlist(context.Background(), testClient, testIDSquirrelly)
+	if err != nil && errors.Is(err, steamweb.ErrServiceUnavailable) {
+		t.Skipf("Service not available currently")
+
+		return
+	}
+
+	if err != nil && errors.Is(err, steamweb.ErrProfilePrivate) {
+		t.Skipf("Wishlist is private")
+
+		return
+	}
+
+	require.NoError(t, err)
+
+	for _, item := range wishlist {
+		require.Positive(t, item.AppID)
+	}
+}
+
+func TestResolveAppNames(t *testing.T) {
+	ownedGames, err := steamweb.GetOwnedGamesWithOptions(context.Background(), testClient, testIDSquirrelly,
+		&steamweb.GetOwnedGamesOptions{AppIDsFilter: []steamid.AppID{testAppTF2}})
+	if err != nil && errors.Is(err, steamweb.ErrServiceUnavailable) {
+		t.Skipf("Service not available currently")
+
+		return
+	}
+
+	require.NoError(t, err)
+	require.Len(t, ownedGames, 1)
+	require.Empty(t, ownedGames[0].Name)
+	require.NoError(t, steamweb.ResolveAppNames(context.Background(), testClient, ownedGames))
+	require.Equal(t, "Team Fortress 2", ownedGames[0].Name)
+}
+
+func TestGetSingleGamePlaytime(t *testing.T) {
+	playtime, err := steamweb.GetSingleGamePlaytime(context.Background(), testClient, testIDSquirrelly, testAppTF2)
+	if err != nil && errors.Is(err, steamweb.ErrServiceUnavailable) {
+		t.Skipf("Service not available currently")
+
+		return
+	}
+
+	require.NoError(t, err)
+	require.Equal(t, testAppTF2, playtime.AppID)
+	require.Positive(t, playtime.PlaytimeForever)
+}
+
+func TestGetBadges(t *testing.T) {
+	badges, err := steamweb.GetBadges(context.Background(), testClient, testIDDane)
+	if err != nil && errors.Is(err, steamweb.ErrServiceUnavailable) {
+		t.Skipf("Service not available currently")
+
+		return
+	}
+
+	require.NoError(t, err)
+	require.NotNil(t, badges)
+	require.Positive(t, len(badges.Badges))
+	require.Len(t, badges.Badges, len(badges.GameBadges())+len(badges.MetaBadges()))
+}
+
+func TestBadgeStatusPartition(t *testing.T) {
+	status := steamweb.BadgeStatus{Badges: []steamweb.Badge{{BadgeID: 1}, {BadgeID: 2, AppID: 440}, {BadgeID: 13}}}
+
+	require.True(t, status.Badges[1].IsGameBadge())
+	require.Len(t, status.GameBadges(), 1)
+	require.Len(t, status.MetaBadges(), 2)
+	require.Empty(t, steamweb.BadgeStatus{}.GameBadges())
+}
+
+func TestGetCommunityBadgeProgress(t *testing.T) {
+	badgeProgress, err := steamweb.GetCommunityBadgeProgress(context.Background(), testClient, testIDDane)
+	if err != nil && errors.Is(err, steamweb.ErrServiceUnavailable) {
+		t.Skipf("Service not available currently")
+
+		return
+	}
+
+	require.NoError(t, err)
+
+	// Very flaky test?
+	if badgeProgress != nil {
+		require.Positive(t, len(badgeProgress))
+	}
+}
+
+func TestGetAssetClassInfo(t *testing.T) {
+	assetClassInfo, err := steamweb.GetAssetClassInfo(context.Background(), testClient, testAppTF2, []int{195151, 16891096})
+	if err != nil && errors.Is(err, steamweb.ErrServiceUnavailable) {
+		t.Skipf("Service not available currently")
+
+		return
+	}
+
+	require.NoError(t, err)
+	require.NotNil(t, assetClassInfo)
+
+	assetClassInfoDE, errDE := steamweb.GetAssetClassInfo(steamweb.WithLang(context.Background(), "de_DE"),
+		testClient, testAppTF2, []int{195151, 16891096})
+	require.NoError(t, errDE)
+	require.Len(t, assetClassInfoDE, len(assetClassInfo))
+
+	cached, errCached := steamweb.GetAssetClassInfo(context.Background(), testClient, testAppTF2, []int{16891096, 195151})
+	require.NoError(t, errCached)
+	require.Len(t, cached, len(assetClassInfo))
+	require.Equal(t, "16891096", cached[0].ClassID)
+}
+
+func TestGetMarketPriceHistoryLoginRequired(t *testing.T) {
+	_, err := steamweb.GetMarketPriceHistory(context.Background(), testClient, testAppTF2, "Mann Co. Supply Crate Key")
+	require.ErrorIs(t, err, steamweb.ErrLoginRequired)
+}
+
+func TestGetGroupMembers(t *testing.T) {
+	groupMembers, err := steamweb.GetGroupMembers(context.Background(), testClient, steamid.New(103582791429521412))
+	if err != nil && errors.Is(err, steamweb.ErrServiceUnavailable) {
+		t.Skipf("Service not available currently")
+
+		return
+	}
 
 	expected := steamid.New(76561197985607672)
 	found := false
@@ -332,3 +1258,682 @@ func TestGetGroupMembers(t *testing.T) {
 	require.NoError(t, err)
 	require.True(t, found)
 }
+
+func TestPlayerSummaryState(t *testing.T) {
+	offline := steamweb.PlayerSummary{PersonaState: steamweb.StateOffline}
+	require.False(t, offline.IsOnline())
+	require.False(t, offline.IsInGame())
+
+	playing := steamweb.PlayerSummary{PersonaState: steamweb.StateAway, GameID: "440", GameExtraInfo: "Team Fortress 2"}
+	require.True(t, playing.IsOnline())
+	require.True(t, playing.IsInGame())
+
+	mobile := steamweb.PlayerSummary{PersonaStateFlags: int(steamweb.PersonaFlagClientTypeMobile)}
+	require.True(t, mobile.IsOnline())
+	require.False(t, mobile.IsInGame())
+
+	joinable := steamweb.PlayerSummary{
+		PersonaState:      steamweb.StateOnline,
+		PersonaStateFlags: int(steamweb.PersonaFlagHasRichPresence | steamweb.PersonaFlagInJoinableGame),
+	}
+	require.True(t, joinable.IsInGame())
+	require.True(t, joinable.HasPersonaStateFlag(steamweb.PersonaFlagHasRichPresence))
+	require.False(t, joinable.HasPersonaStateFlag(steamweb.PersonaFlagGolden))
+}
+
+func TestGetDotaMatchHistory(t *testing.T) {
+	client := steamweb.NewMockClient(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("account_id") == "1" {
+			_, _ = w.Write([]byte(`{"result":{"status":15,"statusDetail":"Cannot get match history for a user that hasn't allowed it"}}`))
+
+			return
+		}
+
+		require.Equal(t, "5", r.URL.Query().Get("matches_requested"))
+		_, _ = w.Write([]byte(`{"result":{"status":1,"num_results":1,"total_results":500,"results_remaining":499,
+			"matches":[{"match_id":7000000001,"match_seq_num":6000000001,"start_time":1700000000,"lobby_type":7,
+			"players":[{"account_id":25341917,"player_slot":0,"hero_id":14}]}]}}`))
+	}))
+
+	history, err := steamweb.GetDotaMatchHistory(context.Background(), client, steamweb.DotaMatchHistoryOptions{
+		MatchesRequested: 5,
+	})
+	require.NoError(t, err)
+	require.Len(t, history.Matches, 1)
+	require.Equal(t, uint64(7000000001), history.Matches[0].MatchID)
+	require.Equal(t, 14, history.Matches[0].Players[0].HeroID)
+
+	_, errPrivate := steamweb.GetDotaMatchHistory(context.Background(), client, steamweb.DotaMatchHistoryOptions{
+		AccountID: steamid.New(76561197960265729),
+	})
+
+	var dotaErr *steamweb.DotaError
+
+	require.ErrorAs(t, errPrivate, &dotaErr)
+	require.Equal(t, 15, dotaErr.Status)
+	require.ErrorIs(t, errPrivate, steamweb.ErrInvalidResponse)
+}
+
+func TestGetDotaMatchDetails(t *testing.T) {
+	client := steamweb.NewMockClient(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("match_id") == "1" {
+			_, _ = w.Write([]byte(`{"result":{"error":"Match ID not found"}}`))
+
+			return
+		}
+
+		_, _ = w.Write([]byte(`{"result":{"match_id":7000000001,"radiant_win":true,"duration":2400,"game_mode":22,
+			"players":[{"account_id":25341917,"player_slot":132,"hero_id":14,"item_0":1,"item_5":50,
+			"kills":10,"deaths":0,"assists":5,"gold_per_min":600,"ability_upgrades":[{"ability":5003,"time":300,"level":1}]}],
+			"picks_bans":[{"is_pick":true,"hero_id":14,"team":1,"order":0}]}}`))
+	}))
+
+	details, err := steamweb.GetDotaMatchDetails(context.Background(), client, 7000000001)
+	require.NoError(t, err)
+	require.True(t, details.RadiantWin)
+	require.Len(t, details.Players, 1)
+
+	player := details.Players[0]
+	require.False(t, player.IsRadiant())
+	require.Equal(t, [6]int{1, 0, 0, 0, 0, 50}, player.Items())
+	require.InDelta(t, 15.0, player.KDA(), 0.001)
+	require.Len(t, player.AbilityUpgrades, 1)
+
+	_, errMissing := steamweb.GetDotaMatchDetails(context.Background(), client, 1)
+
+	var dotaErr *steamweb.DotaError
+
+	require.ErrorAs(t, errMissing, &dotaErr)
+	require.Equal(t, "Match ID not found", dotaErr.Detail)
+}
+
+func TestStreamAppList(t *testing.T) {
+	client := steamweb.NewMockClient(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"applist":{"apps":[{"appid":10,"name":"Counter-Strike"},
+			{"appid":440,"name":"Team Fortress 2"},{"appid":570,"name":"Dota 2"}]}}`))
+	}))
+	ctx := steamweb.WithCacheBypass(context.Background())
+
+	var seen []int
+
+	require.NoError(t, steamweb.StreamAppList(ctx, client, func(app steamweb.App) bool {
+		seen = append(seen, app.AppID)
+
+		return app.AppID != 440
+	}))
+	require.Equal(t, []int{10, 440}, seen)
+
+	count := 0
+
+	require.NoError(t, steamweb.StreamAppList(ctx, client, func(_ steamweb.App) bool {
+		count++
+
+		return true
+	}))
+	require.Equal(t, 3, count)
+}
+
+func TestGetServersAtAddressDetailed(t *testing.T) {
+	client := steamweb.NewMockClient(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/ISteamApps/GetServersAtAddress/v0001":
+			_, _ = w.Write([]byte(`{"response":{"success":true,"servers":[
+				{"addr":"192.0.2.10:27015","appid":440,"gamedir":"tf","gameport":27015},
+				{"addr":"192.0.2.10:27025","appid":440,"gamedir":"tf","gameport":27025}]}}`))
+		case "/IGameServersService/GetServerList/v1":
+			require.Equal(t, "\\gameaddr\\192.0.2.10", r.URL.Query().Get("filter"))
+			_, _ = w.Write([]byte(`{"response":{"servers":[
+				{"addr":"192.0.2.10:27015","name":"Test Server","map":"pl_upward","players":20,"max_players":24}]}}`))
+		default:
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+	}))
+
+	servers, err := steamweb.GetServersAtAddressDetailed(context.Background(), client, net.ParseIP("192.0.2.10"))
+	require.NoError(t, err)
+	require.Len(t, servers, 2)
+	require.NotNil(t, servers[0].Details)
+	require.Equal(t, "pl_upward", servers[0].Details.Map)
+	require.Equal(t, 20, servers[0].Details.Players)
+	require.Equal(t, 27025, servers[1].GamePort)
+	require.Nil(t, servers[1].Details)
+}
+
+func TestPlayerSummariesAll(t *testing.T) {
+	client := steamweb.NewMockClient(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ids := strings.Split(r.URL.Query().Get("steamids"), ",")
+		players := make([]string, len(ids))
+
+		for index, sid := range ids {
+			players[index] = `{"steamid":"` + sid + `"}`
+		}
+
+		_, _ = w.Write([]byte(`{"response":{"players":[` + strings.Join(players, ",") + `]}}`))
+	}))
+
+	ids := steamid.Collection{steamid.New(0)}
+	for i := range 250 {
+		ids = append(ids, steamid.New(int64(76561197960265729+i)))
+	}
+
+	ids = append(ids, ids[1])
+
+	summaries, err := steamweb.PlayerSummariesAll(context.Background(), client, ids, &steamweb.PlayerSummariesOptions{
+		Concurrency: 2,
+		Limit:       150,
+	})
+	require.NoError(t, err)
+	require.Len(t, summaries, 150)
+	require.Len(t, client.Requests(), 2)
+	require.Equal(t, ids[1].Int64(), summaries[0].SteamID.Int64())
+
+	all, errAll := steamweb.PlayerSummariesAll(context.Background(), client, ids, nil)
+	require.NoError(t, errAll)
+	require.Len(t, all, 250)
+}
+
+func TestSetMaxResponseBytes(t *testing.T) {
+	t.Cleanup(func() { steamweb.SetMaxResponseBytes(0) })
+
+	body := `{"response":{"player_count":1234,"result":1}}`
+	client := steamweb.NewMockClient(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(body))
+	}))
+
+	steamweb.SetMaxResponseBytes(int64(len(body)))
+
+	count, err := steamweb.GetNumberOfCurrentPlayers(context.Background(), client, testAppTF2)
+	require.NoError(t, err)
+	require.Equal(t, 1234, count)
+
+	steamweb.SetMaxResponseBytes(int64(len(body) - 1))
+
+	_, errTooLarge := steamweb.GetNumberOfCurrentPlayers(context.Background(), client, testAppTF2)
+	require.ErrorIs(t, errTooLarge, steamweb.ErrResponseTooLarge)
+
+	steamweb.SetMaxResponseBytes(math.MaxInt64)
+
+	unlimited, errUnlimited := steamweb.GetNumberOfCurrentPlayers(context.Background(), client, testAppTF2)
+	require.NoError(t, errUnlimited)
+	require.Equal(t, 1234, unlimited)
+}
+
+func TestSetStrictDecode(t *testing.T) {
+	t.Cleanup(func() { steamweb.SetStrictDecode(false) })
+
+	client := steamweb.NewMockClient(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"response":{"player_count":1234,"result":1,"new_field":true}}`))
+	}))
+
+	count, err := steamweb.GetNumberOfCurrentPlayers(context.Background(), client, testAppTF2)
+	require.NoError(t, err)
+	require.Equal(t, 1234, count)
+
+	steamweb.SetStrictDecode(true)
+
+	_, errStrict := steamweb.GetNumberOfCurrentPlayers(context.Background(), client, testAppTF2)
+	require.ErrorContains(t, errStrict, "new_field")
+}
+
+func TestGetTradeOffer(t *testing.T) {
+	client := steamweb.NewMockClient(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("tradeofferid") == "1" {
+			_, _ = w.Write([]byte(`{"response":{}}`))
+
+			return
+		}
+
+		require.Equal(t, "true", r.URL.Query().Get("get_descriptions"))
+		_, _ = w.Write([]byte(`{"response":{"offer":{"tradeofferid":"6000000000","accountid_other":25341917,
+			"trade_offer_state":2,"items_to_receive":[{"appid":440,"contextid":"2","assetid":"100","classid":"2674",
+			"instanceid":"0","amount":"1","missing":false}],"is_our_offer":false},
+			"descriptions":[{"appid":440,"classid":"2674","instanceid":"0","name":"Mann Co. Supply Crate Key",
+			"tradable":true,"marketable":true}]}}`))
+	}))
+
+	offer, err := steamweb.GetTradeOffer(context.Background(), client, 6000000000, true)
+	require.NoError(t, err)
+	require.Equal(t, steamweb.TradeOfferStateActive, offer.TradeOfferState)
+	require.Len(t, offer.ItemsToReceive, 1)
+
+	description, found := offer.Description(offer.ItemsToReceive[0])
+	require.True(t, found)
+	require.Equal(t, "Mann Co. Supply Crate Key", description.Name)
+
+	_, errMissing := steamweb.GetTradeOffer(context.Background(), client, 1, false)
+	require.ErrorIs(t, errMissing, steamweb.ErrTradeOfferNotFound)
+}
+
+func TestIsLikelyLimited(t *testing.T) {
+	established := steamweb.PlayerSummary{
+		ProfileState:             steamweb.ProfileStateConfigured,
+		CommunityVisibilityState: steamweb.VisibilityPublic,
+		TimeCreated:              1063407589,
+		AvatarHash:               "b5a7ea3fc5a4a1c3fbd5ab0e8a4ef7e4d0b0ddc4",
+	}
+	require.False(t, steamweb.IsLikelyLimited(established))
+
+	fresh := steamweb.PlayerSummary{
+		ProfileState:             steamweb.ProfileStateNew,
+		CommunityVisibilityState: steamweb.VisibilityPublic,
+		TimeCreated:              int(time.Now().Add(-time.Hour * 24).Unix()),
+		AvatarHash:               "fef49e7fa7e1997310d705b2a6158ff8dc1cdfeb",
+	}
+	require.True(t, steamweb.IsLikelyLimited(fresh))
+
+	private := steamweb.PlayerSummary{
+		ProfileState:             steamweb.ProfileStateConfigured,
+		CommunityVisibilityState: steamweb.VisibilityPrivate,
+		AvatarHash:               "b5a7ea3fc5a4a1c3fbd5ab0e8a4ef7e4d0b0ddc4",
+	}
+	require.False(t, steamweb.IsLikelyLimited(private))
+}
+
+func TestEmptyResponse(t *testing.T) {
+	client := steamweb.NewMockClient(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(" \n\t"))
+	}))
+
+	_, err := steamweb.GetNumberOfCurrentPlayers(context.Background(), client, testAppTF2)
+	require.ErrorIs(t, err, steamweb.ErrEmptyResponse)
+	require.True(t, steamweb.IsRetryable(err))
+}
+
+func TestSetProfileCacheTTL(t *testing.T) {
+	t.Cleanup(func() { steamweb.SetProfileCacheTTL(0) })
+	t.Cleanup(steamweb.ClearCache)
+
+	client := steamweb.NewMockClient(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"response":{"game_count":1,"games":[{"appid":440}]}}`))
+	}))
+
+	ownedGamesTTL := func() time.Duration {
+		steamweb.ClearCache()
+
+		_, err := steamweb.GetOwnedGames(context.Background(), client, steamid.New(76561197960287930))
+		require.NoError(t, err)
+
+		entries := steamweb.CacheEntries()
+		require.Len(t, entries, 1)
+
+		return entries[0].TTL
+	}
+
+	require.Equal(t, time.Minute*5, ownedGamesTTL())
+
+	steamweb.SetProfileCacheTTL(time.Hour)
+	require.Equal(t, time.Hour, ownedGamesTTL())
+
+	steamweb.SetProfileCacheTTL(0)
+	require.Equal(t, time.Minute*5, ownedGamesTTL())
+}
+
+func TestGetOwnedGamesCached(t *testing.T) {
+	client := steamweb.NewMockClient(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"response":{"game_count":1,"games":[{"appid":440,"name":"Team Fortress 2"}]}}`))
+	}))
+	sid := steamid.New(76561197960287999)
+	opts := &steamweb.GetOwnedGamesOptions{IncludeAppInfo: true}
+
+	games, err := steamweb.GetOwnedGamesWithOptions(context.Background(), client, sid, opts)
+	require.NoError(t, err)
+	require.Len(t, games, 1)
+
+	games[0].Name = "modified"
+
+	cached, errCached := steamweb.GetOwnedGamesWithOptions(context.Background(), client, sid, opts)
+	require.NoError(t, errCached)
+	require.Equal(t, "Team Fortress 2", cached[0].Name)
+	require.Len(t, client.Requests(), 1)
+
+	_, errOpts := steamweb.GetOwnedGamesWithOptions(context.Background(), client, sid, nil)
+	require.NoError(t, errOpts)
+	require.Len(t, client.Requests(), 2)
+
+	_, errBypass := steamweb.GetOwnedGamesWithOptions(steamweb.WithCacheBypass(context.Background()), client, sid, opts)
+	require.NoError(t, errBypass)
+	require.Len(t, client.Requests(), 3)
+}
+
+func TestOwnedGamesResolveNames(t *testing.T) {
+	client := steamweb.NewMockClient(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"response":{"game_count":3,"games":[{"appid":440},{"appid":730},{"appid":1}]}}`))
+	}))
+
+	games, err := steamweb.GetOwnedGamesWithOptions(steamweb.WithCacheBypass(context.Background()), client,
+		steamid.New(76561197960287930), &steamweb.GetOwnedGamesOptions{})
+	require.NoError(t, err)
+
+	games[1].Name = "Existing"
+	games.ResolveNames(map[steamid.AppID]steamweb.App{
+		440: {AppID: 440, Name: "Team Fortress 2"},
+		730: {AppID: 730, Name: "Counter-Strike 2"},
+	})
+
+	require.Equal(t, "Team Fortress 2", games[0].Name)
+	require.Equal(t, "Existing", games[1].Name)
+	require.Empty(t, games[2].Name)
+	require.Len(t, client.Requests(), 1)
+}
+
+func TestWatchServerList(t *testing.T) {
+	_, errFilter := steamweb.WatchServerList(context.Background(), nil, map[string]string{"map": "a\\b"}, time.Second)
+	require.ErrorIs(t, errFilter, steamweb.ErrInvalidFilter)
+
+	var (
+		pollMu sync.Mutex
+		polls  int
+	)
+
+	client := steamweb.NewMockClient(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		pollMu.Lock()
+		polls++
+		players := min(polls, 3)
+		pollMu.Unlock()
+
+		_, _ = fmt.Fprintf(w, `{"response":{"servers":[{"addr":"192.0.2.1:27015","players":%d}]}}`, players)
+	}))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	updates, err := steamweb.WatchServerList(ctx, client, map[string]string{"appid": "440"}, time.Millisecond*10)
+	require.NoError(t, err)
+
+	first := <-updates
+	require.NoError(t, first.Err)
+	require.Len(t, first.Added, 1)
+
+	second := <-updates
+	require.Empty(t, second.Added)
+	require.Len(t, second.Changed, 1)
+	require.Equal(t, 2, second.Changed[0].Current.Players)
+
+	cancel()
+
+	for range updates { //nolint:revive
+	}
+}
+
+func TestGetPublishedFileDetails(t *testing.T) {
+	client := steamweb.NewMockClient(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+
+		var files []string
+
+		for index := 0; query.Has(fmt.Sprintf("publishedfileids[%d]", index)); index++ {
+			fileID := query.Get(fmt.Sprintf("publishedfileids[%d]", index))
+			if fileID == "1" {
+				files = append(files, `{"result":9,"publishedfileid":"1"}`)
+
+				continue
+			}
+
+			// Steam omits some files from the response entirely.
+			if fileID == "2" {
+				continue
+			}
+
+			files = append(files, `{"result":1,"publishedfileid":"`+fileID+`","title":"File `+fileID+`"}`)
+		}
+
+		_, _ = w.Write([]byte(`{"response":{"publishedfiledetails":[` + strings.Join(files, ",") + `]}}`))
+	}))
+
+	fileIDs := []uint64{1, 2}
+	for index := range 150 {
+		fileIDs = append(fileIDs, uint64(3000000000+index))
+	}
+
+	details, errs := steamweb.GetPublishedFileDetails(context.Background(), client, append(fileIDs, fileIDs[2]))
+	require.Len(t, details, 150)
+	require.Len(t, errs, 2)
+	require.ErrorIs(t, errs[1], steamweb.ErrFileNotFound)
+	require.ErrorIs(t, errs[2], steamweb.ErrFileNotFound)
+	require.Equal(t, "File 3000000000", details[3000000000].Title)
+	require.Len(t, client.Requests(), 2)
+
+	cached, errsCached := steamweb.GetPublishedFileDetails(context.Background(), client, fileIDs[2:])
+	require.Empty(t, errsCached)
+	require.Len(t, cached, 150)
+	require.Len(t, client.Requests(), 2)
+}
+
+func TestGetAssetClassInfoMap(t *testing.T) {
+	client := steamweb.NewMockClient(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"result":{"success":true,
+			"101785959":{"classid":"101785959","name":"Mann Co. Supply Crate Key"},
+			"2674":{"classid":"2674","name":"Refined Metal"}}}`))
+	}))
+	ctx := steamweb.WithCacheBypass(context.Background())
+
+	assets, missing, err := steamweb.GetAssetClassInfoMap(ctx, client, testAppTF2, []int{101785959, 999, 2674, 999})
+	require.NoError(t, err)
+	require.Len(t, assets, 2)
+	require.Equal(t, "Refined Metal", assets[2674].Name)
+	require.Equal(t, []int{999}, missing)
+}
+
+func TestKeyCapabilities(t *testing.T) {
+	publisher := false
+	client := steamweb.NewMockClient(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/ISteamUser/GetPlayerSummaries/v0002/":
+			_, _ = w.Write([]byte(`{"response":{"players":[]}}`))
+		case "/ICheatReportingService/GetCheatingReports/v1":
+			if !publisher {
+				w.WriteHeader(http.StatusForbidden)
+
+				return
+			}
+
+			w.WriteHeader(http.StatusBadRequest)
+		case "/ISteamApps/GetPartnerAppListForWebAPIKey/v2":
+			_, _ = w.Write([]byte(`{"applist":{"apps":{"app":[{"appid":440,"app_type":"game","app_name":"Team Fortress 2"}]}}}`))
+		}
+	}))
+
+	info, err := steamweb.KeyCapabilities(context.Background(), client)
+	require.NoError(t, err)
+	require.True(t, info.Standard)
+	require.False(t, info.Publisher)
+	require.Empty(t, info.PartnerApps)
+
+	publisher = true
+
+	info, err = steamweb.KeyCapabilities(context.Background(), client)
+	require.NoError(t, err)
+	require.True(t, info.Publisher)
+	require.Len(t, info.PartnerApps, 1)
+	require.Equal(t, steamid.AppID(440), info.PartnerApps[0].AppID)
+
+	rejected := steamweb.NewMockClient(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	}))
+
+	info, err = steamweb.KeyCapabilities(context.Background(), rejected)
+	require.NoError(t, err)
+	require.False(t, info.Standard)
+}
+
+func TestFriendsSince(t *testing.T) {
+	start := time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(0, 1, 0)
+	friends := []steamweb.Friend{
+		{FriendSince: 0},
+		{FriendSince: int(start.Add(-time.Second).Unix())},
+		{FriendSince: int(start.Unix())},
+		{FriendSince: int(start.AddDate(0, 0, 14).Unix())},
+		{FriendSince: int(end.Unix())},
+	}
+
+	require.True(t, friends[0].Since().IsZero())
+	require.Equal(t, start.Unix(), friends[2].Since().Unix())
+
+	march := steamweb.FriendsSince(friends, start, end)
+	require.Len(t, march, 2)
+	require.Equal(t, friends[2], march[0])
+	require.Equal(t, friends[3], march[1])
+
+	require.Len(t, steamweb.FriendsSince(friends, start, time.Time{}), 3)
+	require.Len(t, steamweb.FriendsSince(friends, time.Time{}, time.Time{}), 4)
+}
+
+func TestWithValidateAppID(t *testing.T) {
+	// The mock app list is written to the shared cache.
+	t.Cleanup(steamweb.ClearCache)
+
+	client := steamweb.NewMockClient(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/ISteamApps/GetAppList/v2":
+			_, _ = w.Write([]byte(`{"applist":{"apps":[{"appid":440,"name":"Team Fortress 2"}]}}`))
+		default:
+			_, _ = w.Write([]byte(`{"response":{"player_count":1234,"result":1}}`))
+		}
+	}))
+	ctx := steamweb.WithValidateAppID(steamweb.WithCacheBypass(context.Background()))
+
+	count, err := steamweb.GetNumberOfCurrentPlayers(ctx, client, testAppTF2)
+	require.NoError(t, err)
+	require.Equal(t, 1234, count)
+
+	_, errUnknown := steamweb.GetNumberOfCurrentPlayers(ctx, client, 441)
+	require.ErrorIs(t, errUnknown, steamweb.ErrUnknownAppID)
+	require.ErrorContains(t, errUnknown, "441")
+	require.Len(t, client.Requests(), 3)
+
+	_, errUnvalidated := steamweb.GetNumberOfCurrentPlayers(context.Background(), client, 441)
+	require.NoError(t, errUnvalidated)
+	require.Len(t, client.Requests(), 4)
+}
+
+func TestClient(t *testing.T) {
+	t.Cleanup(steamweb.ClearCache)
+	steamweb.ClearCache()
+
+	const (
+		keyA = "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+		keyB = "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb"
+	)
+
+	mock := steamweb.NewMockClient(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"apilist":{"interfaces":[{"name":"ISteamUser","methods":[]}]}}`))
+	}))
+
+	_, errKey := steamweb.NewClient("short")
+	require.Error(t, errKey)
+
+	_, errLang := steamweb.NewClient(keyA, steamweb.WithClientLang("english"))
+	require.Error(t, errLang)
+
+	clientA, errA := steamweb.NewClient(keyA, steamweb.WithClientHTTPClient(mock), steamweb.WithClientLang("de_DE"))
+	require.NoError(t, errA)
+
+	clientB, errB := steamweb.NewClient(keyB, steamweb.WithClientHTTPClient(mock))
+	require.NoError(t, errB)
+
+	for range 2 {
+		_, err := clientA.GetSupportedAPIList(context.Background())
+		require.NoError(t, err)
+	}
+
+	// Each client has its own cache, so the second client must make its own request.
+	_, errList := clientB.GetSupportedAPIList(context.Background())
+	require.NoError(t, errList)
+
+	requests := mock.Requests()
+	require.Len(t, requests, 2)
+	require.Equal(t, keyA, requests[0].URL.Query().Get("key"))
+	require.Equal(t, keyB, requests[1].URL.Query().Get("key"))
+	require.Len(t, clientA.CacheEntries(), 1)
+	require.Empty(t, steamweb.CacheEntries())
+
+	clientA.ClearCache()
+	require.Empty(t, clientA.CacheEntries())
+	require.Len(t, clientB.CacheEntries(), 1)
+}
+
+func TestClientLang(t *testing.T) {
+	mock := steamweb.NewMockClient(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"playerstats":{"gameName":"Team Fortress 2"}}`))
+	}))
+
+	client, errClient := steamweb.NewClient("", steamweb.WithClientHTTPClient(mock), steamweb.WithClientLang("de_DE"))
+	require.NoError(t, errClient)
+
+	_, err := client.GetUserStatsForGame(context.Background(), testIDSquirrelly, testAppTF2)
+	require.NoError(t, err)
+
+	_, errCtx := client.GetUserStatsForGame(steamweb.WithLang(context.Background(), "fr_FR"), testIDSquirrelly, testAppTF2)
+	require.NoError(t, errCtx)
+
+	requests := mock.Requests()
+	require.Len(t, requests, 2)
+	require.Equal(t, "de_de", requests[0].URL.Query().Get("l"))
+	require.Equal(t, "fr_fr", requests[1].URL.Query().Get("l"))
+	// An empty key falls back to the package level key.
+	require.Equal(t, steamweb.Key(), requests[0].URL.Query().Get("key"))
+}
+
+func TestClientCircuitBreaker(t *testing.T) {
+	steamweb.SetCircuitBreaker(1, time.Minute)
+	t.Cleanup(func() { steamweb.SetCircuitBreaker(0, 0) })
+
+	const (
+		limitedKey = "cccccccccccccccccccccccccccccccc"
+		otherKey   = "dddddddddddddddddddddddddddddddd"
+	)
+
+	mock := steamweb.NewMockClient(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("key") == limitedKey {
+			w.WriteHeader(http.StatusTooManyRequests)
+
+			return
+		}
+
+		_, _ = w.Write([]byte(`{"apilist":{"interfaces":[]}}`))
+	}))
+
+	limited, errLimited := steamweb.NewClient(limitedKey, steamweb.WithClientHTTPClient(mock))
+	require.NoError(t, errLimited)
+
+	other, errOther := steamweb.NewClient(otherKey, steamweb.WithClientHTTPClient(mock))
+	require.NoError(t, errOther)
+
+	_, errLimitedList := limited.GetSupportedAPIList(context.Background())
+	require.ErrorIs(t, errLimitedList, steamweb.ErrServiceRateLimit)
+
+	_, errOpen := limited.GetSupportedAPIList(context.Background())
+	require.ErrorIs(t, errOpen, steamweb.ErrCircuitOpen)
+	require.Len(t, limited.OpenCircuits(), 1)
+
+	// A rate limited key must not block requests made with a different key.
+	_, errOtherList := other.GetSupportedAPIList(context.Background())
+	require.NoError(t, errOtherList)
+	require.Empty(t, other.OpenCircuits())
+	require.Empty(t, steamweb.OpenCircuits())
+}
+
+func TestClientAppListAge(t *testing.T) {
+	t.Cleanup(steamweb.ClearCache)
+	steamweb.ClearCache()
+
+	mock := steamweb.NewMockClient(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"applist":{"apps":[{"appid":440,"name":"Team Fortress 2"}]}}`))
+	}))
+
+	client, errClient := steamweb.NewClient("", steamweb.WithClientHTTPClient(mock))
+	require.NoError(t, errClient)
+
+	_, found := client.AppListAge()
+	require.False(t, found)
+
+	_, err := client.GetAppList(context.Background())
+	require.NoError(t, err)
+
+	_, found = client.AppListAge()
+	require.True(t, found)
+
+	_, foundGlobal := steamweb.AppListAge()
+	require.False(t, foundGlobal)
+}
@@ -0,0 +1,321 @@
+package steamweb
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/leighmacdonald/steamid/v4/steamid"
+	"github.com/pkg/errors"
+)
+
+// PublishedFileQueryType controls the ordering of QueryFiles results.
+type PublishedFileQueryType int
+
+// PublishedFileQueryType options
+//
+//goland:noinspection ALL
+const (
+	QueryRankedByVote PublishedFileQueryType = iota
+	QueryRankedByPublicationDate
+	QueryAcceptedForGameRankedByAcceptanceDate
+	QueryRankedByTrend
+	QueryFavoritedByFriendsRankedByPublicationDate
+	QueryCreatedByFriendsRankedByPublicationDate
+	QueryRankedByNumTimesReported
+	QueryCreatedByFollowedUsersRankedByPublicationDate
+	QueryNotYetRated
+	QueryRankedByTotalUniqueSubscriptions
+	QueryRankedByTotalVotesAsc
+	QueryRankedByVotesUp
+	QueryRankedByTextSearch
+)
+
+// maxFilesPerPage is the maximum number of results steam returns per QueryFiles request.
+const maxFilesPerPage = 100
+
+// PublishedFileTag is a workshop tag applied to a published file.
+type PublishedFileTag struct {
+	Tag         string `json:"tag"`
+	DisplayName string `json:"display_name"`
+}
+
+// PublishedFileVoteData contains the voting results for a published file.
+type PublishedFileVoteData struct {
+	Score     float64 `json:"score"`
+	VotesUp   int     `json:"votes_up"`
+	VotesDown int     `json:"votes_down"`
+}
+
+// PublishedFileDetails describes a workshop item or other user generated content.
+type PublishedFileDetails struct {
+	Result                int                   `json:"result"`
+	PublishedFileID       string                `json:"publishedfileid"`
+	Creator               steamid.SteamID       `json:"creator"`
+	CreatorAppID          steamid.AppID         `json:"creator_appid"`
+	ConsumerAppID         steamid.AppID         `json:"consumer_appid"`
+	Filename              string                `json:"filename"`
+	FileSize              string                `json:"file_size"`
+	PreviewURL            string                `json:"preview_url"`
+	URL                   string                `json:"url"`
+	Title                 string                `json:"title"`
+	FileDescription       string                `json:"file_description"`
+	ShortDescription      string                `json:"short_description"`
+	TimeCreated           int64                 `json:"time_created"`
+	TimeUpdated           int64                 `json:"time_updated"`
+	Visibility            int                   `json:"visibility"`
+	Banned                bool                  `json:"banned"`
+	BanReason             string                `json:"ban_reason"`
+	AppName               string                `json:"app_name"`
+	FileType              int                   `json:"file_type"`
+	Subscriptions         int                   `json:"subscriptions"`
+	Favorited             int                   `json:"favorited"`
+	Followers             int                   `json:"followers"`
+	LifetimeSubscriptions int                   `json:"lifetime_subscriptions"`
+	LifetimeFavorited     int                   `json:"lifetime_favorited"`
+	LifetimeFollowers     int                   `json:"lifetime_followers"`
+	Views                 int                   `json:"views"`
+	NumCommentsPublic     int                   `json:"num_comments_public"`
+	Tags                  []PublishedFileTag    `json:"tags"`
+	VoteData              PublishedFileVoteData `json:"vote_data"`
+}
+
+// QueryFilesOptions controls which files are returned by QueryFiles.
+type QueryFilesOptions struct {
+	QueryType PublishedFileQueryType
+	// AppID is the app the files belong to.
+	AppID steamid.AppID
+	// NumPerPage is the number of results per page, max 100. Defaults to 100 when unset.
+	NumPerPage int
+	// Cursor is used to fetch the next page of results. The first page is fetched when empty.
+	Cursor string
+	// RequiredTags only includes files with the tags, by default only one of the tags must match.
+	RequiredTags []string
+	// MatchAllTags requires files to match all RequiredTags.
+	MatchAllTags bool
+	ExcludedTags []string
+	SearchText   string
+}
+
+// QueryFilesResult is a single page of QueryFiles results.
+type QueryFilesResult struct {
+	Total int                    `json:"total"`
+	Files []PublishedFileDetails `json:"publishedfiledetails"`
+	// NextCursor is passed as QueryFilesOptions.Cursor to fetch the next page.
+	NextCursor string `json:"next_cursor"`
+}
+
+// QueryFiles performs a search query for published files, such as workshop items. Use QueryFilesAll to fetch
+// every page of results.
+func QueryFiles(ctx context.Context, client HTTPClientHandler, opts QueryFilesOptions) (*QueryFilesResult, error) {
+	type response struct {
+		Response QueryFilesResult `json:"response"`
+	}
+
+	numPerPage := opts.NumPerPage
+	if numPerPage <= 0 || numPerPage > maxFilesPerPage {
+		numPerPage = maxFilesPerPage
+	}
+
+	cursor := opts.Cursor
+	if cursor == "" {
+		cursor = "*"
+	}
+
+	values := url.Values{
+		"query_type":               []string{fmt.Sprintf("%d", opts.QueryType)},
+		"appid":                    []string{fmt.Sprintf("%d", opts.AppID)},
+		"numperpage":               []string{fmt.Sprintf("%d", numPerPage)},
+		"cursor":                   []string{cursor},
+		"match_all_tags":           []string{strconv.FormatBool(opts.MatchAllTags)},
+		"return_tags":              []string{"true"},
+		"return_vote_data":         []string{"true"},
+		"return_short_description": []string{"true"},
+	}
+
+	for index, tag := range opts.RequiredTags {
+		values.Set(fmt.Sprintf("requiredtags[%d]", index), tag)
+	}
+
+	for index, tag := range opts.ExcludedTags {
+		values.Set(fmt.Sprintf("excludedtags[%d]", index), tag)
+	}
+
+	if opts.SearchText != "" {
+		values.Set("search_text", opts.SearchText)
+	}
+
+	var resp response
+
+	if errResp := apiRequest(ctx, client, "/IPublishedFileService/QueryFiles/v1", values, &resp); errResp != nil {
+		return nil, errResp
+	}
+
+	return &resp.Response, nil
+}
+
+// QueryFilesAll walks every page of QueryFiles results, starting from opts.Cursor, calling fn with the files
+// of each page. Paging stops when the results are exhausted, the context is cancelled or fn returns an error,
+// which is returned as is. Pages are requested no faster than the rate set with SetBatchRequestRate.
+func QueryFilesAll(ctx context.Context, client HTTPClientHandler, opts QueryFilesOptions,
+	fn func(files []PublishedFileDetails) error,
+) error {
+	for {
+		if errCtx := ctx.Err(); errCtx != nil {
+			return errors.Wrap(errCtx, "Query cancelled")
+		}
+
+		if errWait := batchLimiter.Wait(ctx); errWait != nil {
+			return errors.Wrap(errWait, "Query cancelled")
+		}
+
+		result, errQuery := QueryFiles(ctx, client, opts)
+		if errQuery != nil {
+			return errQuery
+		}
+
+		if len(result.Files) == 0 {
+			return nil
+		}
+
+		if errFn := fn(result.Files); errFn != nil {
+			return errFn
+		}
+
+		if result.NextCursor == "" || result.NextCursor == opts.Cursor {
+			return nil
+		}
+
+		opts.Cursor = result.NextCursor
+	}
+}
+
+const (
+	// maxFileDetailsPerRequest is the number of file ids requested from GetDetails at once.
+	maxFileDetailsPerRequest = 100
+	// fileResultOK and fileResultNotFound are the steam EResult values of PublishedFileDetails.Result.
+	fileResultOK       = 1
+	fileResultNotFound = 9
+)
+
+// ErrFileNotFound is recorded by GetPublishedFileDetails for files that do not exist or are not visible.
+var ErrFileNotFound = errors.New("Published file not found")
+
+// GetPublishedFileDetails fetches the details of any number of published files, such as workshop items. The ids
+// are split into chunks of 100 which are fetched concurrently. Successful results and per-file errors are
+// returned separately, keyed by file id. Files that do not exist or are missing from the response have
+// ErrFileNotFound recorded, and every file of a chunk that fails has the chunk error recorded, so every requested
+// id is present in one of the maps. Details are cached per file for 6 hours.
+func GetPublishedFileDetails(ctx context.Context, client HTTPClientHandler, fileIDs []uint64) (map[uint64]PublishedFileDetails, map[uint64]error) {
+	details := make(map[uint64]PublishedFileDetails, len(fileIDs))
+	errs := map[uint64]error{}
+
+	var missing []uint64
+
+	seen := make(map[uint64]bool, len(fileIDs))
+
+	for _, fileID := range fileIDs {
+		if seen[fileID] {
+			continue
+		}
+
+		seen[fileID] = true
+
+		if file, found := getCached[PublishedFileDetails](ctx, cacheFrom(ctx), newCacheKey(cacheKeyPublishedFile, fileID)); found {
+			details[fileID] = file
+
+			continue
+		}
+
+		missing = append(missing, fileID)
+	}
+
+	var chunks [][]uint64
+
+	for len(missing) > maxFileDetailsPerRequest {
+		missing, chunks = missing[maxFileDetailsPerRequest:], append(chunks, missing[:maxFileDetailsPerRequest])
+	}
+
+	if len(missing) > 0 {
+		chunks = append(chunks, missing)
+	}
+
+	indexes := make([]int, len(chunks))
+	for index := range chunks {
+		indexes[index] = index
+	}
+
+	results, chunkErrs := fanOut(ctx, indexes, maxConcurrentRequests, func(ctx context.Context, index int) ([]PublishedFileDetails, error) {
+		return getPublishedFileDetails(ctx, client, chunks[index])
+	})
+
+	for index, errChunk := range chunkErrs {
+		for _, fileID := range chunks[index] {
+			errs[fileID] = errChunk
+		}
+	}
+
+	for index, files := range results {
+		for position, file := range files {
+			fileID, errID := strconv.ParseUint(file.PublishedFileID, 10, 64)
+			if errID != nil {
+				// Missing files may be returned without their id, they are matched by position instead.
+				if position >= len(chunks[index]) {
+					continue
+				}
+
+				fileID = chunks[index][position]
+			}
+
+			switch file.Result {
+			case fileResultOK:
+				details[fileID] = file
+				cacheFrom(ctx).set(newCacheKey(cacheKeyPublishedFile, fileID), file, cacheTTL(ctx, defaultCacheTTL))
+			case fileResultNotFound:
+				errs[fileID] = errors.Wrapf(ErrFileNotFound, "%d", fileID)
+			default:
+				errs[fileID] = errors.Wrapf(ErrInvalidResponse, "File %d result: %d", fileID, file.Result)
+			}
+		}
+
+		// Any requested files steam left out of the response entirely are reported as not found.
+		for _, fileID := range chunks[index] {
+			_, foundDetails := details[fileID]
+			_, foundErr := errs[fileID]
+
+			if !foundDetails && !foundErr {
+				errs[fileID] = errors.Wrapf(ErrFileNotFound, "%d", fileID)
+			}
+		}
+	}
+
+	return details, errs
+}
+
+// getPublishedFileDetails performs a single GetDetails request.
+func getPublishedFileDetails(ctx context.Context, client HTTPClientHandler, fileIDs []uint64) ([]PublishedFileDetails, error) {
+	type response struct {
+		Response struct {
+			Files []PublishedFileDetails `json:"publishedfiledetails"`
+		} `json:"response"`
+	}
+
+	values := url.Values{
+		"includetags":       []string{"true"},
+		"includevotes":      []string{"true"},
+		"short_description": []string{"true"},
+	}
+
+	for index, fileID := range fileIDs {
+		values.Set(fmt.Sprintf("publishedfileids[%d]", index), strconv.FormatUint(fileID, 10))
+	}
+
+	var resp response
+
+	if errResp := apiRequest(ctx, client, "/IPublishedFileService/GetDetails/v1", values, &resp); errResp != nil {
+		return nil, errResp
+	}
+
+	return resp.Response.Files, nil
+}